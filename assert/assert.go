@@ -0,0 +1,248 @@
+// Package assert provides testify style assertions for log entries captured
+// with zltest.Tester.
+//
+// Every function takes a log source which is one of *zltest.Tester,
+// zltest.Entries or *zltest.Entry, reports failure with t.Errorf and
+// returns true if the assertion succeeded. The optional msgAndArgs are
+// added to the failure message the same way testify does it.
+package assert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rzajac/zltest"
+)
+
+// TestingT is an interface wrapper around *testing.T.
+type TestingT = assert.TestingT
+
+// tHelper is implemented by *testing.T and *testing.B.
+type tHelper interface {
+	Helper()
+}
+
+// Len asserts there are n log entries.
+func Len(t TestingT, logs interface{}, n int, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	src, ok := entries(t, logs, msgAndArgs)
+	if !ok {
+		return false
+	}
+	if have := len(src.get()); have != n {
+		return fail(t, src.render(all), fmt.Sprintf("expected %d entries got %d:", n, have), msgAndArgs)
+	}
+	return true
+}
+
+// Logged asserts at least one log entry matches m.
+func Logged(t TestingT, logs interface{}, m zltest.Matcher, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return anyEntry(t, logs, m, "expected matching log entry", msgAndArgs)
+}
+
+// NotLogged asserts no log entry matches m.
+func NotLogged(t TestingT, logs interface{}, m zltest.Matcher, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return noEntry(t, logs, m, "expected no matching log entry", msgAndArgs)
+}
+
+// Msg asserts at least one log entry has message msg.
+func Msg(t TestingT, logs interface{}, msg string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	m := func(ent *zltest.Entry) bool { return ent.HasMsg(msg) }
+	return anyEntry(t, logs, m, fmt.Sprintf("expected log entry with message '%s'", msg), msgAndArgs)
+}
+
+// NotMsg asserts no log entry has message msg.
+func NotMsg(t TestingT, logs interface{}, msg string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	m := func(ent *zltest.Entry) bool { return ent.HasMsg(msg) }
+	return noEntry(t, logs, m, fmt.Sprintf("expected no log entry with message '%s'", msg), msgAndArgs)
+}
+
+// Str asserts at least one log entry has a string field key equal to exp.
+func Str(t TestingT, logs interface{}, key, exp string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	m := func(ent *zltest.Entry) bool { return ent.HasStr(key, exp) }
+	return anyEntry(t, logs, m, fmt.Sprintf("expected log entry with '%s' field '%s'", key, exp), msgAndArgs)
+}
+
+// Num asserts at least one log entry has a number field key equal to exp.
+func Num(t TestingT, logs interface{}, key string, exp float64, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	m := func(ent *zltest.Entry) bool { return ent.HasNum(key, exp) }
+	return anyEntry(t, logs, m, fmt.Sprintf("expected log entry with '%s' field '%v'", key, exp), msgAndArgs)
+}
+
+// Bool asserts at least one log entry has a boolean field key equal to exp.
+func Bool(t TestingT, logs interface{}, key string, exp bool, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	m := func(ent *zltest.Entry) bool { return ent.HasBool(key, exp) }
+	return anyEntry(t, logs, m, fmt.Sprintf("expected log entry with '%s' field '%v'", key, exp), msgAndArgs)
+}
+
+// Error asserts at least one log entry has error field
+// (zerolog.ErrorFieldName) equal to exp.
+func Error(t TestingT, logs interface{}, exp string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	m := func(ent *zltest.Entry) bool { return ent.HasError(exp) }
+	return anyEntry(t, logs, m, fmt.Sprintf("expected log entry with error '%s'", exp), msgAndArgs)
+}
+
+// Level asserts at least one log entry was logged at level.
+func Level(t TestingT, logs interface{}, level zerolog.Level, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	m := func(ent *zltest.Entry) bool { return ent.IsLevel(level) }
+	return anyEntry(t, logs, m, fmt.Sprintf("expected log entry with level '%s'", level), msgAndArgs)
+}
+
+// NoLevelAbove asserts no log entry was logged at level above level.
+func NoLevelAbove(t TestingT, logs interface{}, level zerolog.Level, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	m := func(ent *zltest.Entry) bool {
+		lvl, status := ent.Level()
+		return status == zltest.KeyFound && lvl > level && lvl <= zerolog.PanicLevel
+	}
+	return noEntry(t, logs, m, fmt.Sprintf("expected no log entries with level above '%s'", level), msgAndArgs)
+}
+
+// NoErrors asserts no log entry was logged at zerolog.ErrorLevel or above.
+func NoErrors(t TestingT, logs interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return NoLevelAbove(t, logs, zerolog.WarnLevel, msgAndArgs...)
+}
+
+// NoDuplicateKeys asserts no log entry has a field key written more than
+// once.
+func NoDuplicateKeys(t TestingT, logs interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	m := func(ent *zltest.Entry) bool { return ent.HasDuplicateKeys() }
+	return noEntry(t, logs, m, "expected no log entries with duplicate keys", msgAndArgs)
+}
+
+// anyEntry asserts at least one log entry matches m.
+func anyEntry(t TestingT, logs interface{}, m zltest.Matcher, msg string, msgAndArgs []interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	src, ok := entries(t, logs, msgAndArgs)
+	if !ok {
+		return false
+	}
+	for _, ent := range src.get() {
+		if m(ent) {
+			return true
+		}
+	}
+	return fail(t, src.render(all), msg+" in:", msgAndArgs)
+}
+
+// noEntry asserts no log entry matches m.
+func noEntry(t TestingT, logs interface{}, m zltest.Matcher, msg string, msgAndArgs []interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	src, ok := entries(t, logs, msgAndArgs)
+	if !ok {
+		return false
+	}
+	if found := src.render(m); len(found) > 0 {
+		return fail(t, found, msg+" but got:", msgAndArgs)
+	}
+	return true
+}
+
+// source represents log entries from the log source.
+type source struct {
+	ets zltest.Entries // Entries of *zltest.Tester or zltest.Entries.
+	ent *zltest.Entry  // Entry when the log source is *zltest.Entry.
+}
+
+// get returns all log entries.
+func (src source) get() []*zltest.Entry {
+	if src.ent != nil {
+		return []*zltest.Entry{src.ent}
+	}
+	return src.ets.Get()
+}
+
+// render returns log entries matching m rendered with the Renderer
+// configured with zltest.WithRenderer option.
+func (src source) render(m zltest.Matcher) []string {
+	if src.ent != nil {
+		if m(src.ent) {
+			return src.ent.Render()
+		}
+		return nil
+	}
+	return src.ets.FindAll(m).Render()
+}
+
+// all matches all log entries.
+func all(*zltest.Entry) bool { return true }
+
+// entries returns log entries from the log source. It reports failure
+// if logs is not one of the supported types.
+func entries(t TestingT, logs interface{}, msgAndArgs []interface{}) (source, bool) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	switch src := logs.(type) {
+	case *zltest.Tester:
+		return source{ets: src.Entries()}, true
+	case zltest.Entries:
+		return source{ets: src}, true
+	case *zltest.Entry:
+		return source{ent: src}, true
+	default:
+		msg := fmt.Sprintf(
+			"expected *zltest.Tester, zltest.Entries or *zltest.Entry got %T",
+			logs,
+		)
+		return source{}, assert.Fail(t, msg, msgAndArgs...)
+	}
+}
+
+// fail reports failure message followed by the rendered log entries.
+func fail(t TestingT, lines []string, msg string, msgAndArgs []interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if len(lines) == 0 {
+		return assert.Fail(t, msg+"\n  <no entries>", msgAndArgs...)
+	}
+	for i, line := range lines {
+		lines[i] = "  " + strings.Replace(line, "\n", "\n  ", -1)
+	}
+	return assert.Fail(t, msg+"\n"+strings.Join(lines, "\n"), msgAndArgs...)
+}
@@ -0,0 +1,175 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	tassert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rzajac/zltest"
+)
+
+// recT implements TestingT recording failure messages.
+type recT struct {
+	msgs []string
+}
+
+func (rt *recT) Errorf(format string, args ...interface{}) {
+	rt.msgs = append(rt.msgs, fmt.Sprintf(format, args...))
+}
+
+func Test_Assertions_Success(t *testing.T) {
+	// --- Given ---
+	tst := zltest.New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Info().Str("str", "abc").Int("num", 1).Bool("bool", true).Msg("msg0")
+	log.Warn().Str("error", "err").Msg("msg1")
+
+	// --- Then ---
+	rt := &recT{}
+	ent := tst.LastEntry()
+	checks := []bool{
+		Len(rt, tst, 2),
+		Len(rt, tst.Entries(), 2),
+		Len(rt, ent, 1),
+		Logged(rt, tst, func(e *zltest.Entry) bool { return e.HasKey("num") }),
+		NotLogged(rt, tst, func(e *zltest.Entry) bool { return e.HasKey("other") }),
+		Msg(rt, tst, "msg0"),
+		Msg(rt, ent, "msg1"),
+		NotMsg(rt, tst, "msg2"),
+		Str(rt, tst, "str", "abc"),
+		Num(rt, tst, "num", 1),
+		Bool(rt, tst.Entries(), "bool", true),
+		Error(rt, tst, "err"),
+		Level(rt, tst, zerolog.WarnLevel),
+		NoLevelAbove(rt, tst, zerolog.WarnLevel),
+		NoErrors(rt, tst),
+		NoDuplicateKeys(rt, tst),
+	}
+	for i, ok := range checks {
+		tassert.True(t, ok, "check %d", i)
+	}
+	tassert.Empty(t, rt.msgs)
+}
+
+func Test_Assertions_Failure(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		check func(rt TestingT, tst *zltest.Tester) bool
+		exp   string
+	}{
+		{
+			"Len",
+			func(rt TestingT, tst *zltest.Tester) bool { return Len(rt, tst, 2) },
+			"expected 2 entries got 1:\n  {\"level\":\"error\",\"message\":\"msg0\"}",
+		},
+		{
+			"Msg",
+			func(rt TestingT, tst *zltest.Tester) bool { return Msg(rt, tst, "msg1") },
+			"expected log entry with message 'msg1' in:\n  {\"level\":\"error\",\"message\":\"msg0\"}",
+		},
+		{
+			"NotMsg",
+			func(rt TestingT, tst *zltest.Tester) bool { return NotMsg(rt, tst, "msg0") },
+			"expected no log entry with message 'msg0' but got:\n  {\"level\":\"error\",\"message\":\"msg0\"}",
+		},
+		{
+			"NoErrors",
+			func(rt TestingT, tst *zltest.Tester) bool { return NoErrors(rt, tst) },
+			"expected no log entries with level above 'warn' but got:\n  {\"level\":\"error\",\"message\":\"msg0\"}",
+		},
+		{
+			"Level",
+			func(rt TestingT, tst *zltest.Tester) bool { return Level(rt, tst, zerolog.InfoLevel) },
+			"expected log entry with level 'info' in:\n  {\"level\":\"error\",\"message\":\"msg0\"}",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			tst := zltest.New(t)
+			log := zerolog.New(tst)
+			log.Error().Msg("msg0")
+			rt := &recT{}
+
+			// --- When ---
+			ok := tc.check(rt, tst)
+
+			// --- Then ---
+			tassert.False(t, ok)
+			require.Len(t, rt.msgs, 1)
+			// Testify indents multi-line messages, so check line by line.
+			for _, line := range strings.Split(tc.exp, "\n") {
+				tassert.Contains(t, rt.msgs[0], line)
+			}
+		})
+	}
+}
+
+func Test_Assertions_NoEntries(t *testing.T) {
+	// --- Given ---
+	tst := zltest.New(t)
+	rt := &recT{}
+
+	// --- When ---
+	ok := Msg(rt, tst, "msg")
+
+	// --- Then ---
+	tassert.False(t, ok)
+	require.Len(t, rt.msgs, 1)
+	tassert.Contains(t, rt.msgs[0], "<no entries>")
+}
+
+func Test_Assertions_MsgAndArgs(t *testing.T) {
+	// --- Given ---
+	tst := zltest.New(t)
+	rt := &recT{}
+
+	// --- When ---
+	Len(rt, tst, 1, "user %s", "bob")
+
+	// --- Then ---
+	require.Len(t, rt.msgs, 1)
+	tassert.Contains(t, rt.msgs[0], "user bob")
+}
+
+func Test_Assertions_BadSource(t *testing.T) {
+	// --- Given ---
+	rt := &recT{}
+
+	// --- When ---
+	ok := Len(rt, "abc", 1)
+
+	// --- Then ---
+	tassert.False(t, ok)
+	require.Len(t, rt.msgs, 1)
+	exp := "expected *zltest.Tester, zltest.Entries or *zltest.Entry got string"
+	tassert.Contains(t, rt.msgs[0], exp)
+}
+
+func Test_Assertions_Renderer(t *testing.T) {
+	// --- Given ---
+	tst := zltest.New(t, zltest.WithRenderer(zltest.TableRenderer("level", "message")))
+	log := zerolog.New(tst)
+	log.Error().Msg("msg0")
+	log.Info().Msg("msg1")
+	rt := &recT{}
+
+	// --- When ---
+	ok := NoErrors(rt, tst)
+
+	// --- Then ---
+	tassert.False(t, ok)
+	require.Len(t, rt.msgs, 1)
+	tassert.Contains(t, rt.msgs[0], "level | message\n")
+	tassert.Contains(t, rt.msgs[0], "error | msg0")
+	tassert.NotContains(t, rt.msgs[0], "msg1")
+	tassert.NotContains(t, rt.msgs[0], `{"level"`)
+}
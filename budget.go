@@ -0,0 +1,143 @@
+package zltest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// budgetTop is the number of top offending messages in budget reports.
+const budgetTop = 5
+
+// Budget represents limits on logging done by the code under test.
+// Zero values mean no limit.
+type Budget struct {
+	MaxEntries int                   // Maximum number of log entries.
+	MaxBytes   int                   // Maximum number of bytes written.
+	PerLevel   map[zerolog.Level]int // Maximum number of entries per level.
+	PerMsg     map[string]int        // Maximum number of entries per message.
+}
+
+// ExpBudget tests logging didn't exceed the budget. On failure it reports
+// all exceeded limits followed by the top offending messages by number of
+// entries and by number of bytes. Entries without level are counted as
+// zerolog.NoLevel. It calls Fatal when any of the log entries cannot be
+// decoded.
+func (tst *Tester) ExpBudget(b Budget) {
+	tst.t.Helper()
+	tst.mx.RLock()
+	cnt, size := tst.cnt, len(tst.buf)
+	tst.mx.RUnlock()
+
+	lines := make([]string, 0)
+	if b.MaxEntries > 0 && cnt > b.MaxEntries {
+		lines = append(lines, fmt.Sprintf("  entries %d > %d", cnt, b.MaxEntries))
+	}
+	if b.MaxBytes > 0 && size > b.MaxBytes {
+		lines = append(lines, fmt.Sprintf("  bytes %d > %d", size, b.MaxBytes))
+	}
+
+	ets := tst.Entries().Get()
+	levels := make(map[zerolog.Level]int)
+	msgs := make(map[string]*msgUsage)
+	for _, ent := range ets {
+		lvl, status := ent.Level()
+		if status != KeyFound {
+			lvl = zerolog.NoLevel
+		}
+		levels[lvl]++
+
+		msg, _ := ent.m[zerolog.MessageFieldName].(string)
+		use, ok := msgs[msg]
+		if !ok {
+			use = &msgUsage{msg: msg}
+			msgs[msg] = use
+		}
+		use.cnt++
+		// Count the new line trimmed from the entry, the same as in the total.
+		use.size += len(ent.raw) + 1
+	}
+
+	for _, lvl := range sortedLevels(b.PerLevel) {
+		if limit := b.PerLevel[lvl]; limit > 0 && levels[lvl] > limit {
+			lines = append(lines, fmt.Sprintf("  level '%s' entries %d > %d", lvl, levels[lvl], limit))
+		}
+	}
+	for _, msg := range sortedMsgs(b.PerMsg) {
+		var have int
+		if use, ok := msgs[msg]; ok {
+			have = use.cnt
+		}
+		if limit := b.PerMsg[msg]; limit > 0 && have > limit {
+			lines = append(lines, fmt.Sprintf("  message '%s' entries %d > %d", msg, have, limit))
+		}
+	}
+
+	if len(lines) == 0 {
+		return
+	}
+
+	uses := make([]*msgUsage, 0, len(msgs))
+	for _, use := range msgs {
+		uses = append(uses, use)
+	}
+	tst.t.Errorf(
+		"log budget exceeded:\n%s\ntop messages by entries:\n%s\ntop messages by bytes:\n%s",
+		strings.Join(lines, "\n"),
+		topUsage(uses, func(a, b *msgUsage) bool { return a.cnt > b.cnt }),
+		topUsage(uses, func(a, b *msgUsage) bool { return a.size > b.size }),
+	)
+}
+
+// msgUsage represents number of entries and bytes logged with a message.
+type msgUsage struct {
+	msg  string // Log message.
+	cnt  int    // Number of entries.
+	size int    // Number of bytes.
+}
+
+// topUsage returns report of budgetTop messages sorted with less. Ties
+// are sorted by message.
+func topUsage(uses []*msgUsage, less func(a, b *msgUsage) bool) string {
+	sorted := append([]*msgUsage{}, uses...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if less(sorted[i], sorted[j]) {
+			return true
+		}
+		if less(sorted[j], sorted[i]) {
+			return false
+		}
+		return sorted[i].msg < sorted[j].msg
+	})
+	if len(sorted) > budgetTop {
+		sorted = sorted[:budgetTop]
+	}
+
+	lines := make([]string, len(sorted))
+	for i, use := range sorted {
+		lines[i] = fmt.Sprintf("  '%s' entries %d bytes %d", use.msg, use.cnt, use.size)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// sortedLevels returns sorted keys of the map.
+func sortedLevels(m map[zerolog.Level]int) []zerolog.Level {
+	lvls := make([]zerolog.Level, 0, len(m))
+	for lvl := range m {
+		lvls = append(lvls, lvl)
+	}
+	sort.Slice(lvls, func(i, j int) bool { return lvls[i] < lvls[j] })
+	return lvls
+}
+
+// sortedMsgs returns sorted keys of the map.
+func sortedMsgs(m map[string]int) []string {
+	msgs := make([]string, 0, len(m))
+	for msg := range m {
+		msgs = append(msgs, msg)
+	}
+	sort.Strings(msgs)
+	return msgs
+}
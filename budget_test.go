@@ -0,0 +1,96 @@
+package zltest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/rzajac/zltest/internal"
+)
+
+func Test_Tester_ExpBudget(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Debug().Msg("tick")
+	log.Debug().Msg("tick")
+	log.Info().Msg("done")
+
+	// --- Then ---
+	tst.ExpBudget(Budget{
+		MaxEntries: 3,
+		MaxBytes:   200,
+		PerLevel:   map[zerolog.Level]int{zerolog.DebugLevel: 2},
+		PerMsg:     map[string]int{"tick": 2, "other": 1},
+	})
+}
+
+func Test_Tester_ExpBudget_exceeded(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"log budget exceeded:\n%s\ntop messages by entries:\n%s\ntop messages by bytes:\n%s",
+		"  entries 4 > 2\n"+
+			"  bytes 269 > 100\n"+
+			"  level 'debug' entries 3 > 1\n"+
+			"  message 'tick' entries 3 > 2",
+		"  'tick' entries 3 bytes 105\n"+
+			"  'done with a long message' entries 1 bytes 164",
+		"  'done with a long message' entries 1 bytes 164\n"+
+			"  'tick' entries 3 bytes 105",
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Debug().Msg("tick")
+	log.Debug().Msg("tick")
+	log.Debug().Msg("tick")
+	log.Info().Str("data", strings.Repeat("x", 100)).Msg("done with a long message")
+
+	// --- When ---
+	tst.ExpBudget(Budget{
+		MaxEntries: 2,
+		MaxBytes:   100,
+		PerLevel:   map[zerolog.Level]int{zerolog.DebugLevel: 1, zerolog.InfoLevel: 1},
+		PerMsg:     map[string]int{"tick": 2},
+	})
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_topUsage(t *testing.T) {
+	// --- Given ---
+	uses := []*msgUsage{
+		{msg: "a", cnt: 1, size: 50},
+		{msg: "b", cnt: 5, size: 10},
+		{msg: "c", cnt: 2, size: 20},
+		{msg: "d", cnt: 2, size: 20},
+		{msg: "e", cnt: 1, size: 1},
+		{msg: "f", cnt: 1, size: 2},
+	}
+
+	// --- When ---
+	byCnt := topUsage(uses, func(a, b *msgUsage) bool { return a.cnt > b.cnt })
+	bySize := topUsage(uses, func(a, b *msgUsage) bool { return a.size > b.size })
+
+	// --- Then ---
+	expCnt := "  'b' entries 5 bytes 10\n" +
+		"  'c' entries 2 bytes 20\n" +
+		"  'd' entries 2 bytes 20\n" +
+		"  'a' entries 1 bytes 50\n" +
+		"  'e' entries 1 bytes 1"
+	assert.Exactly(t, expCnt, byCnt)
+	expSize := "  'a' entries 1 bytes 50\n" +
+		"  'c' entries 2 bytes 20\n" +
+		"  'd' entries 2 bytes 20\n" +
+		"  'b' entries 5 bytes 10\n" +
+		"  'f' entries 1 bytes 2"
+	assert.Exactly(t, expSize, bySize)
+}
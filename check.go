@@ -0,0 +1,175 @@
+package zltest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Checker collects failures of assertions made on log entries and reports
+// them as a single report grouped by entry.
+type Checker struct {
+	t        T                   // Test manager.
+	failFast bool                // Report and call Fatal on first failure.
+	ets      Entries             // Entries reporting failures to the Checker.
+	groups   []string            // Failure group names in order of first failure.
+	fails    map[string][]string // Failure messages by group name.
+}
+
+// Check runs f with a Checker and reports all collected assertion failures
+// with one call to Error when f returns.
+func (tst *Tester) Check(f func(c *Checker)) {
+	tst.t.Helper()
+	tst.check(f, false)
+}
+
+// CheckFatal runs f with a Checker and reports collected assertion failures
+// with a call to Fatal on the first failure.
+func (tst *Tester) CheckFatal(f func(c *Checker)) {
+	tst.t.Helper()
+	tst.check(f, true)
+}
+
+// check runs f with a Checker and reports collected failures.
+func (tst *Tester) check(f func(c *Checker), failFast bool) {
+	tst.t.Helper()
+	c := &Checker{
+		t:        tst.t,
+		failFast: failFast,
+		groups:   make([]string, 0),
+		fails:    make(map[string][]string),
+	}
+
+	ets := tst.Entries().Get()
+	c.ets = Entries{
+		e: make([]*Entry, len(ets)),
+		r: tst.r,
+		t: &checkT{c: c, group: "entries"},
+	}
+	for i, ent := range ets {
+		cpy := *ent
+		cpy.t = &checkT{c: c, group: entryGroup(tst.r, i, ent)}
+		c.ets.e[i] = &cpy
+	}
+
+	f(c)
+	if len(c.groups) > 0 {
+		c.t.Error(c.report())
+	}
+}
+
+// entryGroup returns failure group name for n-th log entry rendered with r.
+// Rendered lines following the first one are aligned with it.
+func entryGroup(r Renderer, n int, ent *Entry) string {
+	prefix := fmt.Sprintf("entry %d: ", n)
+	lines := strings.Join(renderer(r)([]*Entry{ent}), "\n")
+	pad := "\n  " + strings.Repeat(" ", len(prefix))
+	return prefix + strings.Replace(lines, "\n", pad, -1)
+}
+
+// Entries returns all logged entries reporting failures to the Checker.
+func (c *Checker) Entries() Entries {
+	return c.ets
+}
+
+// FirstEntry returns first log entry reporting failures to the Checker or
+// nil if no log entries were written.
+func (c *Checker) FirstEntry() *Entry {
+	if len(c.ets.e) == 0 {
+		return nil
+	}
+	return c.ets.e[0]
+}
+
+// LastEntry returns last log entry reporting failures to the Checker or
+// nil if no log entries were written.
+func (c *Checker) LastEntry() *Entry {
+	if len(c.ets.e) == 0 {
+		return nil
+	}
+	return c.ets.e[len(c.ets.e)-1]
+}
+
+// Failed returns true if any assertion failed so far.
+func (c *Checker) Failed() bool {
+	return len(c.groups) > 0
+}
+
+// fail records failure message in a group. When fatal is true or the
+// Checker is in fail fast mode it reports failures collected so far with
+// a call to Fatal.
+func (c *Checker) fail(group, msg string, fatal bool) {
+	c.t.Helper()
+	if _, ok := c.fails[group]; !ok {
+		c.groups = append(c.groups, group)
+	}
+	c.fails[group] = append(c.fails[group], msg)
+
+	if fatal || c.failFast {
+		rep := c.report()
+		c.groups = c.groups[:0]
+		c.fails = make(map[string][]string)
+		c.t.Fatal(rep)
+	}
+}
+
+// report returns report of all collected failures.
+func (c *Checker) report() string {
+	var b strings.Builder
+	b.WriteString("log assertions failed:")
+	for _, group := range c.groups {
+		b.WriteString("\n  " + group + ":")
+		for _, msg := range c.fails[group] {
+			b.WriteString("\n    " + msg)
+		}
+	}
+	return b.String()
+}
+
+// checkT implements T interface collecting failures in the Checker.
+type checkT struct {
+	c     *Checker // Checker collecting failures.
+	group string   // Failure group name.
+}
+
+// Error implements T interface.
+func (ct *checkT) Error(args ...interface{}) {
+	ct.c.t.Helper()
+	ct.c.fail(ct.group, sprint(args...), false)
+}
+
+// Errorf implements T interface.
+func (ct *checkT) Errorf(format string, args ...interface{}) {
+	ct.c.t.Helper()
+	ct.c.fail(ct.group, fmt.Sprintf(format, args...), false)
+}
+
+// Fatal implements T interface.
+func (ct *checkT) Fatal(args ...interface{}) {
+	ct.c.t.Helper()
+	ct.c.fail(ct.group, sprint(args...), true)
+}
+
+// Fatalf implements T interface.
+func (ct *checkT) Fatalf(format string, args ...interface{}) {
+	ct.c.t.Helper()
+	ct.c.fail(ct.group, fmt.Sprintf(format, args...), true)
+}
+
+// Helper implements T interface.
+func (ct *checkT) Helper() { ct.c.t.Helper() }
+
+// Log implements T interface.
+func (ct *checkT) Log(args ...interface{}) { ct.c.t.Log(args...) }
+
+// Logf implements T interface.
+func (ct *checkT) Logf(format string, args ...interface{}) {
+	ct.c.t.Logf(format, args...)
+}
+
+// Cleanup implements T interface.
+func (ct *checkT) Cleanup(f func()) { ct.c.t.Cleanup(f) }
+
+// sprint formats its arguments the same way testing.T.Error does.
+func sprint(args ...interface{}) string {
+	return strings.TrimSuffix(fmt.Sprintln(args...), "\n")
+}
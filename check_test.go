@@ -0,0 +1,97 @@
+package zltest
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/rzajac/zltest/internal"
+)
+
+func Test_Tester_Check(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Info().Str("key0", "val0").Msg("msg0")
+	log.Error().Str("key1", "val1").Msg("msg1")
+
+	// --- Then ---
+	tst.Check(func(c *Checker) {
+		c.FirstEntry().ExpStr("key0", "val0")
+		c.LastEntry().ExpMsg("msg1")
+		c.Entries().ExpLen(2)
+		assert.False(t, c.Failed())
+	})
+}
+
+func Test_Tester_Check_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Error", "log assertions failed:\n"+
+		`  entry 0: {"level":"info","key0":"val0","message":"msg0"}:`+"\n"+
+		"    expected entry key 'key0' to have value 'val1' but got 'val0'\n"+
+		"    expected entry to have key 'key2'\n"+
+		`  entry 1: {"level":"error","message":"msg1"}:`+"\n"+
+		"    expected entry key 'message' to have value 'msg' but got 'msg1'\n"+
+		"  entries:\n"+
+		"    expected 3 entries got 2",
+	).Once()
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Info().Str("key0", "val0").Msg("msg0")
+	log.Error().Msg("msg1")
+
+	// --- When ---
+	tst.Check(func(c *Checker) {
+		c.FirstEntry().ExpStr("key0", "val1")
+		c.LastEntry().ExpMsg("msg")
+		c.Entries().ExpLen(3)
+		c.Entries().ExpEntry(0).ExpStr("key2", "val2")
+		assert.True(t, c.Failed())
+	})
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Tester_CheckFatal(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Fatal", "log assertions failed:\n"+
+		`  entry 0: {"level":"info","message":"msg0"}:`+"\n"+
+		"    expected entry key 'message' to have value 'msg' but got 'msg0'",
+	).Once()
+	mck.On("Fatal", "log assertions failed:\n"+
+		"  entries:\n"+
+		"    expected 3 entries got 1",
+	).Once()
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Info().Msg("msg0")
+
+	// --- When ---
+	tst.CheckFatal(func(c *Checker) {
+		c.FirstEntry().ExpMsg("msg")
+		c.Entries().ExpLen(3)
+	})
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Checker_noEntries(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+
+	// --- Then ---
+	tst.Check(func(c *Checker) {
+		assert.Nil(t, c.FirstEntry())
+		assert.Nil(t, c.LastEntry())
+		c.Entries().ExpLen(0)
+	})
+}
@@ -0,0 +1,146 @@
+package zltest
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rs/zerolog"
+)
+
+// ExpCmp tests log entry is equal to exp using cmp.Diff with opts. The log
+// entry is decoded into a new value of the exp type before the comparison,
+// so exp may be a map[string]interface{} or a struct with JSON tags. On
+// mismatch the failure message contains the diff.
+//
+// When exp is a map use CmpTimes, CmpDurs and CmpInts options (or
+// CmpZerolog which combines them) to compare time.Time, time.Duration and
+// integer values with the JSON values decoded from the log entry.
+func (ent *Entry) ExpCmp(exp interface{}, opts ...cmp.Option) {
+	ent.t.Helper()
+	if exp == nil {
+		ent.t.Fatal("expected value must not be nil")
+		return
+	}
+	have := reflect.New(reflect.TypeOf(exp))
+	if err := json.Unmarshal([]byte(ent.raw), have.Interface()); err != nil {
+		ent.t.Fatal(err)
+		return
+	}
+	if diff := cmp.Diff(exp, have.Elem().Interface(), opts...); diff != "" {
+		ent.t.Errorf("log entry mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// CmpZerolog returns cmp.Options with CmpTimes, CmpDurs and CmpInts.
+func CmpZerolog() cmp.Options {
+	return cmp.Options{CmpTimes(), CmpDurs(), CmpInts()}
+}
+
+// CmpTimes returns cmp.Option comparing time.Time values with strings
+// formatted using zerolog.TimeFieldFormat. The time string is transformed
+// to time.Time when the other value is time.Time.
+func CmpTimes() cmp.Option {
+	return cmp.FilterValues(
+		func(x, y interface{}) bool {
+			_, xt := x.(time.Time)
+			_, yt := y.(time.Time)
+			return xt != yt && isTime(x) && isTime(y)
+		},
+		cmp.Transformer("zltest.Time", func(v interface{}) interface{} {
+			if str, ok := v.(string); ok {
+				tim, _ := time.Parse(zerolog.TimeFieldFormat, str)
+				return tim
+			}
+			return v
+		}),
+	)
+}
+
+// isTime returns true if v is time.Time or a string formatted with
+// zerolog.TimeFieldFormat.
+func isTime(v interface{}) bool {
+	switch val := v.(type) {
+	case time.Time:
+		return true
+	case string:
+		_, err := time.Parse(zerolog.TimeFieldFormat, val)
+		return err == nil
+	default:
+		return false
+	}
+}
+
+// CmpDurs returns cmp.Option comparing time.Duration values with numbers
+// written in zerolog.DurationFieldUnit units. The number is transformed
+// to time.Duration when the other value is time.Duration.
+func CmpDurs() cmp.Option {
+	return cmp.FilterValues(
+		func(x, y interface{}) bool {
+			_, xd := x.(time.Duration)
+			_, yd := y.(time.Duration)
+			return xd != yd && isDur(x) && isDur(y)
+		},
+		cmp.Transformer("zltest.Dur", func(v interface{}) interface{} {
+			if num, ok := v.(float64); ok {
+				return time.Duration(num * float64(zerolog.DurationFieldUnit))
+			}
+			return v
+		}),
+	)
+}
+
+// isDur returns true if v is time.Duration or float64.
+func isDur(v interface{}) bool {
+	switch v.(type) {
+	case time.Duration, float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// CmpInts returns cmp.Option comparing numbers of any integer or float
+// type as int64 when both values represent exact integers. It allows to
+// compare Go integer literals with JSON numbers decoded as float64.
+func CmpInts() cmp.Option {
+	return cmp.FilterValues(
+		func(x, y interface{}) bool {
+			_, xi := x.(int64)
+			_, yi := y.(int64)
+			_, xok := exactInt(x)
+			_, yok := exactInt(y)
+			return !(xi && yi) && xok && yok
+		},
+		cmp.Transformer("zltest.Int", func(v interface{}) interface{} {
+			i, _ := exactInt(v)
+			return i
+		}),
+	)
+}
+
+// exactInt returns v as int64 and true if v is a number representing
+// an exact integer.
+func exactInt(v interface{}) (int64, bool) {
+	if _, ok := v.(time.Duration); ok {
+		return 0, false
+	}
+	val := reflect.ValueOf(v)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return val.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := val.Uint()
+		return int64(u), u <= math.MaxInt64
+	case reflect.Float32, reflect.Float64:
+		f := val.Float()
+		if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(f), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,165 @@
+package zltest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/rzajac/zltest/internal"
+)
+
+func Test_Entry_ExpCmp_map(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+	tim := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// --- When ---
+	log.Info().
+		Str("str", "val").
+		Int("int", 42).
+		Float64("float", 1.5).
+		Time("time", tim).
+		Dur("dur", 3*time.Second).
+		Ints("ints", []int{1, 2}).
+		Msg("msg")
+
+	// --- Then ---
+	exp := map[string]interface{}{
+		"level":   "info",
+		"str":     "val",
+		"int":     42,
+		"float":   1.5,
+		"time":    tim,
+		"dur":     3 * time.Second,
+		"ints":    []interface{}{1, 2},
+		"message": "msg",
+	}
+	tst.LastEntry().ExpCmp(exp, CmpZerolog())
+}
+
+func Test_Entry_ExpCmp_struct(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+	tim := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// --- When ---
+	log.Info().Str("str", "val").Time("time", tim).Msg("msg")
+
+	// --- Then ---
+	type entry struct {
+		Str  string    `json:"str"`
+		Time time.Time `json:"time"`
+		Msg  string    `json:"message"`
+	}
+	tst.LastEntry().ExpCmp(entry{Str: "val", Time: tim, Msg: "msg"})
+}
+
+func Test_Entry_ExpCmp_ignore(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst).With().Timestamp().Logger()
+
+	// --- When ---
+	log.Info().Msg("msg")
+
+	// --- Then ---
+	exp := map[string]interface{}{"level": "info", "message": "msg"}
+	ignore := cmpopts.IgnoreMapEntries(func(key string, _ interface{}) bool {
+		return key == zerolog.TimestampFieldName
+	})
+	tst.LastEntry().ExpCmp(exp, ignore)
+}
+
+func Test_Entry_ExpCmp_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"log entry mismatch (-want +got):\n%s",
+		mock.MatchedBy(func(diff string) bool {
+			return strings.Contains(diff, `"str"`) && strings.Contains(diff, "val")
+		}),
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Info().Str("str", "val").Msg("msg")
+
+	// --- When ---
+	exp := map[string]interface{}{"level": "info", "str": "v", "message": "msg"}
+	tst.LastEntry().ExpCmp(exp)
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpCmp_nil(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Fatal", "expected value must not be nil")
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Info().Msg("msg")
+
+	// --- When ---
+	tst.LastEntry().ExpCmp(nil)
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpCmp_badType(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Fatal", mock.AnythingOfType("*json.UnmarshalTypeError"))
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Info().Msg("msg")
+
+	// --- When ---
+	tst.LastEntry().ExpCmp([]string{})
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_exactInt(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		val interface{}
+		exp int64
+		ok  bool
+	}{
+		{"int", 1, 1, true},
+		{"int8", int8(-2), -2, true},
+		{"uint", uint(3), 3, true},
+		{"float", 4.0, 4, true},
+		{"fraction", 4.5, 0, false},
+		{"duration", time.Second, 0, false},
+		{"string", "1", 0, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			got, ok := exactInt(tc.val)
+
+			// --- Then ---
+			assert.Exactly(t, tc.exp, got)
+			assert.Exactly(t, tc.ok, ok)
+		})
+	}
+}
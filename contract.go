@@ -0,0 +1,249 @@
+package zltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// Contract describes fields required in log entries with given message.
+//
+// For example the contract for "payment processed" message with number
+// amount, three letter currency code and order ID of any type:
+//
+//	Contract{
+//	    Msg:    "payment processed",
+//	    Fields: map[string]string{"amount": "number", "order_id": ""},
+//	    Schema: `{"properties": {"currency": {"type": "string", "pattern": "^[A-Z]{3}$"}}, "required": ["currency"]}`,
+//	}
+type Contract struct {
+	// Log message (zerolog.MessageFieldName) the contract applies to.
+	Msg string
+
+	// Required field keys and their JSON Schema types (string, number,
+	// integer, boolean, object, array or null). Empty type accepts any
+	// value.
+	Fields map[string]string
+
+	// Optional JSON Schema the log entry must be valid against.
+	// See Entries.ExpSchema for supported keywords.
+	Schema string
+}
+
+// WithContracts is Tester option registering contracts. The log entries
+// are validated against them when the test completes, see ExpContracts.
+func WithContracts(cs ...Contract) Option {
+	return func(tst *Tester) {
+		tst.t.Helper()
+		for _, c := range cs {
+			tst.AddContract(c)
+		}
+	}
+}
+
+// StrictContracts is Tester option which makes entries with a message no
+// contract was registered for fail the contract validation. It registers
+// T.Cleanup function calling ExpContracts.
+func StrictContracts() Option {
+	return func(tst *Tester) {
+		tst.t.Helper()
+		tst.cs.mx.Lock()
+		defer tst.cs.mx.Unlock()
+		tst.cs.strict = true
+		tst.registerContractsCleanup()
+	}
+}
+
+// ContractsOnWrite is Tester option making Tester validate log entries
+// against contracts as they are written, so entries dropped by Reset are
+// validated too. Since entries may be written from any goroutine, even after
+// the test completes, violations are not reported from Write but by the next
+// ExpContracts call or when the test completes.
+func ContractsOnWrite() Option {
+	return func(tst *Tester) {
+		tst.cs.mx.Lock()
+		defer tst.cs.mx.Unlock()
+		tst.cs.onWrite = true
+	}
+}
+
+// AddContract registers contract. More than one contract may be registered
+// for the same message, the log entry must satisfy all of them. On the first
+// call it registers T.Cleanup function calling ExpContracts. Invalid
+// contract is reported with a call to Fatal.
+func (tst *Tester) AddContract(c Contract) {
+	tst.t.Helper()
+	sch, err := compileContract(c)
+	if err != nil {
+		tst.t.Fatal(err)
+		return
+	}
+
+	tst.cs.mx.Lock()
+	defer tst.cs.mx.Unlock()
+	tst.cs.byMsg[c.Msg] = append(tst.cs.byMsg[c.Msg], sch)
+	tst.registerContractsCleanup()
+}
+
+// registerContractsCleanup registers T.Cleanup function calling
+// ExpContracts unless it was already registered. The contracts registry
+// must be locked by the caller.
+func (tst *Tester) registerContractsCleanup() {
+	tst.t.Helper()
+	if tst.cs.cleanup {
+		return
+	}
+	tst.cs.cleanup = true
+	tst.t.Cleanup(func() {
+		tst.t.Helper()
+		tst.ExpContracts()
+	})
+}
+
+// ExpContracts tests all log entries satisfy registered contracts. In
+// strict mode entries with a message no contract was registered for fail.
+// When ContractsOnWrite option is used it reports violations found as
+// entries were written since the previous call instead.
+func (tst *Tester) ExpContracts() {
+	tst.t.Helper()
+	tst.cs.mx.Lock()
+	onWrite, failures := tst.cs.onWrite, tst.cs.failures
+	tst.cs.failures = nil
+	tst.cs.mx.Unlock()
+	if onWrite {
+		for _, failure := range failures {
+			tst.t.Errorf("%s\nin:\n%s", failure.msg, failure.entry)
+		}
+		return
+	}
+
+	ets := tst.Entries()
+	for i, ent := range ets.e {
+		if msg := tst.cs.check(i, ent.m); msg != "" {
+			tst.t.Errorf("%s\nin:\n%s", msg, strings.Join(indent(tst.r, []*Entry{ent}), "\n"))
+		}
+	}
+}
+
+// checkWrite validates log entry written to the Tester as n-th entry
+// against contracts when ContractsOnWrite option is used. Violations are
+// collected to be reported by ExpContracts, the method must not call T as it
+// runs on the goroutine writing the log.
+func (tst *Tester) checkWrite(n int, p []byte) {
+	tst.cs.mx.RLock()
+	onWrite := tst.cs.onWrite
+	tst.cs.mx.RUnlock()
+	if !onWrite {
+		return
+	}
+
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(p, &m); err != nil {
+		// Decoding errors are reported by Entries.
+		return
+	}
+	if msg := tst.cs.check(n, m); msg != "" {
+		ent := &Entry{raw: strings.TrimSpace(string(p)), m: m, r: tst.r, t: tst.t}
+		tst.cs.mx.Lock()
+		defer tst.cs.mx.Unlock()
+		tst.cs.failures = append(tst.cs.failures, contractFailure{
+			msg:   msg,
+			entry: strings.Join(indent(tst.r, []*Entry{ent}), "\n"),
+		})
+	}
+}
+
+// contractFailure represents contract violation found when log entry
+// was written.
+type contractFailure struct {
+	msg   string // Failure message.
+	entry string // Rendered log entry.
+}
+
+// contracts represents contract registry.
+type contracts struct {
+	mx      sync.RWMutex         // Guards the registry.
+	byMsg   map[string][]*schema // Compiled contracts by message.
+	strict  bool                 // Fail entries with no contract.
+	onWrite bool                 // Validate entries as they are written.
+	cleanup bool                 // Set when T.Cleanup function is registered.
+
+	// Violations found as entries were written.
+	failures []contractFailure
+}
+
+// newContracts returns empty contract registry.
+func newContracts() *contracts {
+	return &contracts{byMsg: make(map[string][]*schema)}
+}
+
+// check validates decoded n-th log entry against registered contracts.
+// Returns empty string if entry satisfies them or failure message.
+func (cs *contracts) check(n int, m map[string]interface{}) string {
+	cs.mx.RLock()
+	defer cs.mx.RUnlock()
+
+	msg, _ := m[zerolog.MessageFieldName].(string)
+	schs, ok := cs.byMsg[msg]
+	if !ok {
+		if cs.strict {
+			return fmt.Sprintf("expected contract for entry %d with message '%s'", n, msg)
+		}
+		return ""
+	}
+
+	vs := make([]string, 0)
+	for _, sch := range schs {
+		vs = append(vs, sch.validate("#", m)...)
+	}
+	if len(vs) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(
+		"expected entry %d to satisfy contract for message '%s':\n  %s",
+		n,
+		msg,
+		strings.Join(vs, "\n  "),
+	)
+}
+
+// compileContract compiles contract to JSON Schema.
+func compileContract(c Contract) (*schema, error) {
+	keys := make([]string, 0, len(c.Fields))
+	for key := range c.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	props := make(map[string]interface{}, len(c.Fields))
+	required := make([]interface{}, len(keys))
+	for i, key := range keys {
+		required[i] = key
+		if typ := c.Fields[key]; typ != "" {
+			props[key] = map[string]interface{}{"type": typ}
+		}
+	}
+	doc := map[string]interface{}{
+		"required":   required,
+		"properties": props,
+	}
+
+	sc := &schemaCompiler{root: doc, refs: make(map[string]*schema)}
+	sch, err := sc.compile("#", doc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract for message '%s': %w", c.Msg, err)
+	}
+	if c.Schema == "" {
+		return sch, nil
+	}
+
+	usr, err := compileSchema(c.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract for message '%s': %w", c.Msg, err)
+	}
+	return &schema{allOf: []*schema{sch, usr}}, nil
+}
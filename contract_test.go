@@ -0,0 +1,190 @@
+package zltest
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/rzajac/zltest/internal"
+)
+
+// paymentContract is a contract used in tests.
+var paymentContract = Contract{
+	Msg:    "payment processed",
+	Fields: map[string]string{"amount": "number", "order_id": ""},
+	Schema: `{
+		"required": ["currency"],
+		"properties": {"currency": {"type": "string", "pattern": "^[A-Z]{3}$"}}
+	}`,
+}
+
+func Test_WithContracts(t *testing.T) {
+	// --- Given ---
+	tst := New(t, WithContracts(paymentContract), StrictContracts())
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Info().
+		Float64("amount", 9.99).
+		Str("currency", "EUR").
+		Int("order_id", 1).
+		Msg("payment processed")
+
+	// --- Then ---
+	tst.ExpContracts()
+}
+
+func Test_WithContracts_cleanup(t *testing.T) {
+	// --- Given ---
+	var cleanup func()
+
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Cleanup", mock.AnythingOfType("func()")).Run(func(args mock.Arguments) {
+		cleanup = args.Get(0).(func())
+	}).Once()
+	mck.On(
+		"Errorf",
+		"%s\nin:\n%s",
+		"expected entry 1 to satisfy contract for message 'payment processed':\n"+
+			"  #: missing required key 'order_id'\n"+
+			"  #/amount: expected type 'number' but got 'string'\n"+
+			"  #/currency: value 'euro' does not match pattern '^[A-Z]{3}$'",
+		`  {"level":"info","amount":"1","currency":"euro","message":"payment processed"}`,
+	)
+
+	tst := New(mck, WithContracts(paymentContract, Contract{Msg: "other"}))
+	log := zerolog.New(tst)
+	log.Info().Msg("not registered")
+	log.Info().Str("amount", "1").Str("currency", "euro").Msg("payment processed")
+
+	// --- When ---
+	require.NotNil(t, cleanup)
+	cleanup()
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_StrictContracts(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Cleanup", mock.AnythingOfType("func()"))
+	mck.On(
+		"Errorf",
+		"%s\nin:\n%s",
+		"expected contract for entry 0 with message 'not registered'",
+		`  {"level":"info","message":"not registered"}`,
+	)
+
+	tst := New(mck, WithContracts(paymentContract), StrictContracts())
+	log := zerolog.New(tst)
+	log.Info().Msg("not registered")
+
+	// --- When ---
+	tst.ExpContracts()
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_ContractsOnWrite(t *testing.T) {
+	// --- Given ---
+	var cleanup func()
+
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Cleanup", mock.AnythingOfType("func()")).Run(func(args mock.Arguments) {
+		cleanup = args.Get(0).(func())
+	})
+
+	tst := New(mck, ContractsOnWrite(), WithContracts(paymentContract))
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Info().Msg("payment processed")
+	tst.Reset()
+
+	// --- Then ---
+	// Violations are not reported from the writing goroutine.
+	mck.AssertNotCalled(t, "Errorf", mock.Anything, mock.Anything, mock.Anything)
+
+	// Entries dropped by Reset are reported.
+	mck.On(
+		"Errorf",
+		"%s\nin:\n%s",
+		"expected entry 0 to satisfy contract for message 'payment processed':\n"+
+			"  #: missing required key 'amount'\n"+
+			"  #: missing required key 'order_id'\n"+
+			"  #: missing required key 'currency'",
+		`  {"level":"info","message":"payment processed"}`,
+	).Once()
+	tst.ExpContracts()
+	mck.AssertExpectations(t)
+
+	// Reported violations are not reported again at cleanup.
+	require.NotNil(t, cleanup)
+	cleanup()
+	mck.AssertNumberOfCalls(t, "Errorf", 1)
+}
+
+func Test_StrictContracts_cleanup(t *testing.T) {
+	// --- Given ---
+	var cleanup func()
+
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Cleanup", mock.AnythingOfType("func()")).Run(func(args mock.Arguments) {
+		cleanup = args.Get(0).(func())
+	}).Once()
+	mck.On(
+		"Errorf",
+		"%s\nin:\n%s",
+		"expected contract for entry 0 with message 'not registered'",
+		`  {"level":"info","message":"not registered"}`,
+	)
+
+	tst := New(mck, StrictContracts())
+	log := zerolog.New(tst)
+	log.Info().Msg("not registered")
+
+	// --- When ---
+	require.NotNil(t, cleanup)
+	cleanup()
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Tester_AddContract_invalid(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		c Contract
+	}{
+		{"type", Contract{Msg: "msg", Fields: map[string]string{"key": "numbr"}}},
+		{"schema", Contract{Msg: "msg", Schema: "{ bad json }"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			mck := &TMock{}
+			mck.On("Helper")
+			mck.On("Fatal", mock.Anything)
+
+			tst := New(mck)
+
+			// --- When ---
+			tst.AddContract(tc.c)
+
+			// --- Then ---
+			mck.AssertExpectations(t)
+			assert.Empty(t, tst.cs.byMsg)
+		})
+	}
+}
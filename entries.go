@@ -1,6 +1,7 @@
 package zltest
 
 import (
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -9,9 +10,19 @@ import (
 // Entries represents collection of zerolog log entries.
 type Entries struct {
 	e []*Entry // Log entries.
+	r Renderer // Renderer used to print entries.
 	t T        // Test manager.
 }
 
+// name returns the name of the running test or empty string when test
+// manager doesn't implement TB.
+func (ets Entries) name() string {
+	if tb, ok := ets.t.(TB); ok {
+		return tb.Name()
+	}
+	return ""
+}
+
 // Get returns the list of Entry in Entries
 func (ets Entries) Get() []*Entry {
 	return ets.e
@@ -41,6 +52,60 @@ func ordinal(n int) string {
 	}
 }
 
+// Filter returns only entries matching log level. Entries without level
+// field match zerolog.NoLevel.
+func (ets Entries) Filter(level zerolog.Level) Entries {
+	return ets.filter(func(lvl zerolog.Level) bool { return lvl == level })
+}
+
+// FilterMin returns only entries with log level equal to or above min.
+func (ets Entries) FilterMin(min zerolog.Level) Entries {
+	return ets.FilterRange(min, zerolog.PanicLevel)
+}
+
+// FilterRange returns only entries with log level between min and
+// max inclusive.
+func (ets Entries) FilterRange(min, max zerolog.Level) Entries {
+	return ets.filter(func(lvl zerolog.Level) bool {
+		return lvl >= min && lvl <= max && isSeverity(lvl)
+	})
+}
+
+// filter returns only entries with log level for which f returns true.
+// Entries without level field are treated as zerolog.NoLevel, entries with
+// unrecognized level are skipped.
+func (ets Entries) filter(f func(zerolog.Level) bool) Entries {
+	e := make([]*Entry, 0)
+	for _, ent := range ets.e {
+		lvl, status := ent.Level()
+		if status == KeyMissing {
+			lvl, status = zerolog.NoLevel, KeyFound
+		}
+		if status == KeyFound && f(lvl) {
+			e = append(e, ent)
+		}
+	}
+	return Entries{e: e, r: ets.r, t: ets.t}
+}
+
+// isSeverity returns true if level represents message severity, that is
+// it is not zerolog.NoLevel or zerolog.Disabled.
+func isSeverity(level zerolog.Level) bool {
+	return level != zerolog.NoLevel && level != zerolog.Disabled
+}
+
+// ExpNoLevelAbove tests that no log entry has log level above level.
+func (ets Entries) ExpNoLevelAbove(level zerolog.Level) {
+	ets.t.Helper()
+	for _, ent := range ets.FilterRange(level+1, zerolog.PanicLevel).Get() {
+		ets.t.Errorf(
+			"expected no entries with level above '%s' but got:\n%s",
+			level,
+			strings.Join(indent(ets.r, []*Entry{ent}), "\n"),
+		)
+	}
+}
+
 // ExpLen tests that there is want number of entries.
 func (ets Entries) ExpLen(want int) {
 	ets.t.Helper()
@@ -173,6 +238,27 @@ func (ets Entries) NotExpNum(key string, exp float64) {
 	ets.notExp(func(e *Entry) string { return e.expNum(key, exp) })
 }
 
+// ExpStrs tests that at least one log entry has a field key, its value is
+// an array of strings, and it's equal to exp.
+func (ets Entries) ExpStrs(key string, exp []string) {
+	ets.t.Helper()
+	ets.exp(func(e *Entry) string { return e.expStrs(key, exp) })
+}
+
+// ExpArrayLen tests that at least one log entry has a field key, its value
+// is an array, and it has exp elements.
+func (ets Entries) ExpArrayLen(key string, exp int) {
+	ets.t.Helper()
+	ets.exp(func(e *Entry) string { return e.expArrayLen(key, exp) })
+}
+
+// ExpArrayContains tests that at least one log entry has a field key, its
+// value is an array, and at least one of its elements is equal to exp.
+func (ets Entries) ExpArrayContains(key string, exp interface{}) {
+	ets.t.Helper()
+	ets.exp(func(e *Entry) string { return e.expArrayContains(key, exp) })
+}
+
 func (ets Entries) exp(f func(*Entry) string) {
 	ets.t.Helper()
 	e := ets.Get()
@@ -194,11 +280,28 @@ func (ets Entries) notExp(f func(*Entry) string) {
 	}
 }
 
-// Print prints all zerolog log entries.
+// Render returns entries rendered with Renderer configured with WithRenderer
+// option or RawRenderer if none is configured.
+func (ets Entries) Render() []string {
+	return renderer(ets.r)(ets.e)
+}
+
+// Print prints all zerolog log entries using Renderer configured with
+// WithRenderer option or RawRenderer if none is configured.
 func (ets Entries) Print() {
 	ets.t.Helper()
-	ets.t.Log("entries logged so far:")
-	for _, e := range ets.e {
-		ets.t.Log("  " + e.raw)
+	ets.PrintWith(ets.r)
+}
+
+// PrintWith prints all zerolog log entries using Renderer r.
+func (ets Entries) PrintWith(r Renderer) {
+	ets.t.Helper()
+	if name := ets.name(); name != "" {
+		ets.t.Log("entries logged so far in " + name + ":")
+	} else {
+		ets.t.Log("entries logged so far:")
+	}
+	for _, line := range indent(r, ets.e) {
+		ets.t.Log(line)
 	}
 }
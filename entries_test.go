@@ -2,6 +2,7 @@ package zltest
 
 import (
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -487,6 +488,134 @@ func Test_Entries_NotExpStr_found(t *testing.T) {
 	mck.AssertExpectations(t)
 }
 
+func Test_Entries_Filter(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Trace().Msg("trace")
+	log.Debug().Msg("debug")
+	log.Info().Msg("info")
+	log.Warn().Msg("warn")
+	log.Error().Msg("error")
+	log.WithLevel(zerolog.PanicLevel).Msg("panic")
+	log.Log().Msg("no level")
+
+	// --- Then ---
+	ets := tst.Entries()
+	assert.Len(t, ets.Filter(zerolog.InfoLevel).Get(), 1)
+	assert.Len(t, ets.FilterMin(zerolog.WarnLevel).Get(), 3)
+	assert.Len(t, ets.FilterMin(zerolog.TraceLevel).Get(), 6)
+	assert.Len(t, ets.FilterRange(zerolog.DebugLevel, zerolog.WarnLevel).Get(), 3)
+	assert.Len(t, ets.FilterRange(zerolog.WarnLevel, zerolog.DebugLevel).Get(), 0)
+
+	got := ets.FilterMin(zerolog.InfoLevel).FilterRange(zerolog.TraceLevel, zerolog.WarnLevel)
+	got.ExpLen(2)
+	got.ExpMsg("info")
+	got.ExpMsg("warn")
+}
+
+func Test_Entries_Filter_customMarshal(t *testing.T) {
+	// --- Given ---
+	defer func(m func(zerolog.Level) string) { zerolog.LevelFieldMarshalFunc = m }(zerolog.LevelFieldMarshalFunc)
+	zerolog.LevelFieldMarshalFunc = func(l zerolog.Level) string {
+		return strings.ToUpper(l.String())
+	}
+
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Info().Msg("info")
+	log.Error().Msg("error")
+
+	// --- Then ---
+	assert.Len(t, tst.Entries().Filter(zerolog.ErrorLevel).Get(), 1)
+	assert.Len(t, tst.Entries().FilterMin(zerolog.InfoLevel).Get(), 2)
+}
+
+func Test_Entries_ExpNoLevelAbove(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Info().Msg("info")
+	log.Warn().Msg("warn")
+	log.Log().Msg("no level")
+
+	// --- Then ---
+	tst.Entries().ExpNoLevelAbove(zerolog.WarnLevel)
+}
+
+func Test_Entries_ExpNoLevelAbove_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected no entries with level above '%s' but got:\n%s",
+		zerolog.InfoLevel,
+		`  {"level":"error","message":"error"}`,
+	).Once()
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Info().Msg("info")
+	log.Error().Msg("error")
+
+	// --- When ---
+	tst.Entries().ExpNoLevelAbove(zerolog.InfoLevel)
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entries_ExpStrs(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().Strs("strs", []string{"a"}).Send()
+	log.Error().Strs("strs", []string{"a", "b"}).Send()
+
+	// --- Then ---
+	tst.Entries().ExpStrs("strs", []string{"a", "b"})
+}
+
+func Test_Entries_ExpArrayLen(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().Ints("ints", []int{1}).Send()
+	log.Error().Ints("ints", []int{1, 2}).Send()
+
+	// --- Then ---
+	tst.Entries().ExpArrayLen("ints", 2)
+}
+
+func Test_Entries_ExpArrayContains_notFound(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Error", "no matching log entry found")
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Ints("ints", []int{1}).Send()
+	log.Error().Ints("ints", []int{1, 2}).Send()
+
+	// --- When ---
+	tst.Entries().ExpArrayContains("ints", 3)
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
 func Test_Entries_Print(t *testing.T) {
 	// --- Given ---
 	mck := &TMock{}
@@ -1,8 +1,15 @@
 package zltest
 
 import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math"
+	"net"
+	"reflect"
+	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -28,11 +35,43 @@ const (
 	KeyBadFormat KeyStatus = "KeyBadFormat"
 )
 
+// CallerUnmarshalFunc parses the zerolog.CallerFieldName field value. It
+// should be the inverse of zerolog.CallerMarshalFunc, so it must be changed
+// when custom marshal function writes the value in other format. The default
+// parses "file:line" written by the default zerolog.CallerMarshalFunc and
+// "file:line func" form. The returned function name is empty if the value
+// doesn't have it.
+var CallerUnmarshalFunc = func(caller string) (fn, file string, line int, err error) {
+	idx := strings.LastIndex(caller, ":")
+	if idx < 1 {
+		return "", "", 0, fmt.Errorf("invalid caller '%s'", caller)
+	}
+	num := caller[idx+1:]
+	if sp := strings.IndexByte(num, ' '); sp >= 0 {
+		num, fn = num[:sp], strings.TrimSpace(num[sp+1:])
+	}
+	line, err = strconv.Atoi(num)
+	if err != nil {
+		return "", "", 0, err
+	}
+	return fn, caller[:idx], line, nil
+}
+
+// Frame represents one frame of a stack trace logged with
+// zerolog.ErrorStackMarshaler (for example pkgerrors.MarshalStack).
+type Frame struct {
+	Func   string // Function name.
+	Source string // Source file name.
+	Line   int    // Line number.
+}
+
 // Entry represents one zerolog log entry.
 type Entry struct {
-	raw string                 // Entry as it was written to the writer.
-	m   map[string]interface{} // JSON decoded log entry.
-	t   T                      // Test manager.
+	raw  string                 // Entry as it was written to the writer.
+	m    map[string]interface{} // JSON decoded log entry.
+	keys []string               // Field keys in the order they were written.
+	r    Renderer               // Renderer used to print the entry.
+	t    T                      // Test manager.
 }
 
 // String implements fmt.Stringer interface and returns log entry
@@ -41,6 +80,44 @@ func (ent *Entry) String() string {
 	return ent.raw
 }
 
+// Render returns log entry rendered with Renderer configured with
+// WithRenderer option or RawRenderer if none is configured.
+func (ent *Entry) Render() []string {
+	return renderer(ent.r)([]*Entry{ent})
+}
+
+// Keys returns log entry field keys in the order they were written. Keys
+// written more than once are returned as many times as they were written.
+func (ent *Entry) Keys() []string {
+	return ent.keys
+}
+
+// ExpNoDuplicateKeys tests log entry has no field key written more than once.
+func (ent *Entry) ExpNoDuplicateKeys() {
+	ent.t.Helper()
+	if dup := ent.duplicateKeys(); len(dup) > 0 {
+		ent.t.Errorf(
+			"expected entry to have no duplicate keys but got '%s' in:\n%s",
+			strings.Join(dup, "', '"),
+			strings.Join(indent(ent.r, []*Entry{ent}), "\n"),
+		)
+	}
+}
+
+// duplicateKeys returns keys written more than once in the order of their
+// first occurrence.
+func (ent *Entry) duplicateKeys() []string {
+	cnt := make(map[string]int, len(ent.keys))
+	dup := make([]string, 0)
+	for _, key := range ent.keys {
+		cnt[key]++
+		if cnt[key] == 2 {
+			dup = append(dup, key)
+		}
+	}
+	return dup
+}
+
 // ExpKey tests log entry has a field key.
 func (ent *Entry) ExpKey(key string) {
 	ent.t.Helper()
@@ -65,6 +142,50 @@ func (ent *Entry) ExpNumKeys(n int) {
 	}
 }
 
+// ExpKeys tests log entry has exactly the set of field keys exp. The order
+// in which keys were written is not important.
+func (ent *Entry) ExpKeys(exp ...string) {
+	ent.t.Helper()
+	missing, unexpected := keysDiff(exp, ent.keys)
+	if len(missing) > 0 || len(unexpected) > 0 {
+		ent.t.Errorf(
+			"expected entry keys to be %s but missing %s and unexpected %s",
+			joinKeys(exp),
+			joinKeys(missing),
+			joinKeys(unexpected),
+		)
+	}
+}
+
+// ExpKeysSubset tests log entry has all the field keys exp. The entry may
+// have other keys.
+func (ent *Entry) ExpKeysSubset(exp ...string) {
+	ent.t.Helper()
+	if missing, _ := keysDiff(exp, ent.keys); len(missing) > 0 {
+		ent.t.Errorf(
+			"expected entry to have keys %s but missing %s",
+			joinKeys(exp),
+			joinKeys(missing),
+		)
+	}
+}
+
+// ExpKeysOrdered tests log entry field keys were written exactly in
+// the exp order.
+func (ent *Entry) ExpKeysOrdered(exp ...string) {
+	ent.t.Helper()
+	if !equalKeys(exp, ent.keys) {
+		missing, unexpected := keysDiff(exp, ent.keys)
+		ent.t.Errorf(
+			"expected entry keys in order %s but got %s (missing %s, unexpected %s)",
+			joinKeys(exp),
+			joinKeys(ent.keys),
+			joinKeys(missing),
+			joinKeys(unexpected),
+		)
+	}
+}
+
 // Str returns log entry field key as a string.
 func (ent *Entry) Str(key string) (string, KeyStatus) {
 	ent.t.Helper()
@@ -306,6 +427,33 @@ func (ent *Entry) ExpLevel(exp zerolog.Level) {
 	ent.ExpStr(zerolog.LevelFieldName, exp.String())
 }
 
+// Level returns log entry level field (zerolog.LevelFieldName) as
+// zerolog.Level. It handles levels written with custom
+// zerolog.LevelFieldMarshalFunc and numeric levels. It returns KeyBadFormat
+// when the level cannot be recognized.
+func (ent *Entry) Level() (zerolog.Level, KeyStatus) {
+	ent.t.Helper()
+	itf, ok := ent.m[zerolog.LevelFieldName]
+	if !ok {
+		return zerolog.NoLevel, KeyMissing
+	}
+	switch got := itf.(type) {
+	case string:
+		lvl, err := zerolog.ParseLevel(got)
+		if err != nil {
+			return zerolog.NoLevel, KeyBadFormat
+		}
+		return lvl, KeyFound
+	case float64:
+		if got > math.MaxInt8 || got < math.MinInt8 {
+			return zerolog.NoLevel, KeyBadFormat
+		}
+		return zerolog.Level(got), KeyFound
+	default:
+		return zerolog.NoLevel, KeyBadType
+	}
+}
+
 // ExpNum tests log entry has a field key and its numerical value is equal to exp.
 func (ent *Entry) ExpNum(key string, exp float64) {
 	ent.t.Helper()
@@ -345,6 +493,626 @@ func (ent *Entry) Map(key string) (map[string]interface{}, KeyStatus) {
 	return nil, KeyMissing
 }
 
+// Decode unmarshals log entry into v the same way json.Unmarshal does.
+// It calls Fatal on error.
+func (ent *Entry) Decode(v interface{}) {
+	ent.t.Helper()
+	if err := json.Unmarshal([]byte(ent.raw), v); err != nil {
+		ent.t.Fatal(err)
+	}
+}
+
+// DecodeField unmarshals log entry field key into v the same way
+// json.Unmarshal does. When the key was written more than once the last
+// value is used. Returns KeyBadType when the value cannot be unmarshalled
+// into v.
+func (ent *Entry) DecodeField(key string, v interface{}) KeyStatus {
+	ent.t.Helper()
+	fields, err := entryFields([]byte(ent.raw))
+	if err != nil {
+		ent.t.Fatal(err)
+		return KeyBadFormat
+	}
+
+	var raw json.RawMessage
+	for _, fld := range fields {
+		if fld.key == key {
+			raw = fld.val
+		}
+	}
+	if raw == nil {
+		return KeyMissing
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return KeyBadType
+	}
+	return KeyFound
+}
+
+// ExpFields tests log entry has all the fields from exp and their values are
+// equal. The values in exp are compared after JSON round trip, so for example
+// int 1 matches JSON number 1. The entry may have other fields.
+func (ent *Entry) ExpFields(exp map[string]interface{}) {
+	ent.t.Helper()
+	norm, err := normalizeFields(exp)
+	if err != nil {
+		ent.t.Fatal(err)
+		return
+	}
+	if diff := fieldsDiff(norm, ent.m, false); len(diff) > 0 {
+		ent.t.Errorf("expected entry fields to match:\n%s", strings.Join(diff, "\n"))
+	}
+}
+
+// ExpEqual tests log entry is equal to exp JSON object. Fields with keys
+// listed in ignore (for example zerolog.TimestampFieldName) are not compared.
+// It calls Fatal if exp cannot be decoded.
+func (ent *Entry) ExpEqual(exp string, ignore ...string) {
+	ent.t.Helper()
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(exp), &m); err != nil {
+		ent.t.Fatal(err)
+		return
+	}
+
+	have := make(map[string]interface{}, len(ent.m))
+	for key, val := range ent.m {
+		have[key] = val
+	}
+	for _, key := range ignore {
+		delete(m, key)
+		delete(have, key)
+	}
+
+	if diff := fieldsDiff(m, have, true); len(diff) > 0 {
+		ent.t.Errorf("expected entry to be equal:\n%s", strings.Join(diff, "\n"))
+	}
+}
+
+// normalizeFields returns fields as they would be decoded from JSON.
+func normalizeFields(fields map[string]interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]interface{}, len(fields))
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// normalizeValue returns value as it would be decoded from JSON.
+func normalizeValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var norm interface{}
+	if err := json.Unmarshal(data, &norm); err != nil {
+		return nil, err
+	}
+	return norm, nil
+}
+
+// fieldsDiff returns per field differences between exp and have sorted by
+// key. When strict is true fields present only in have are reported too.
+func fieldsDiff(exp, have map[string]interface{}, strict bool) []string {
+	keys := make([]string, 0, len(exp)+len(have))
+	for key := range exp {
+		keys = append(keys, key)
+	}
+	if strict {
+		for key := range have {
+			if _, ok := exp[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+
+	diff := make([]string, 0)
+	for _, key := range keys {
+		expV, expOK := exp[key]
+		haveV, haveOK := have[key]
+		switch {
+		case !haveOK:
+			diff = append(diff, fmt.Sprintf("  key '%s' is missing", key))
+		case !expOK:
+			diff = append(diff, fmt.Sprintf(
+				"  key '%s' is unexpected with value %s",
+				key,
+				formatValue(haveV),
+			))
+		case !reflect.DeepEqual(expV, haveV):
+			diff = append(diff, fmt.Sprintf(
+				"  key '%s' expected %s but got %s",
+				key,
+				formatValue(expV),
+				formatValue(haveV),
+			))
+		}
+	}
+	return diff
+}
+
+// formatValue formats decoded JSON value for error messages.
+func formatValue(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+// Slice returns log entry field key as a slice.
+func (ent *Entry) Slice(key string) ([]interface{}, KeyStatus) {
+	ent.t.Helper()
+	if itf, ok := ent.m[key]; ok {
+		if got, ok := itf.([]interface{}); ok {
+			return got, KeyFound
+		}
+		return nil, KeyBadType
+	}
+	return nil, KeyMissing
+}
+
+// Strs returns log entry field key as a slice of strings. It returns
+// KeyBadType if the field is not an array or any of its elements is not
+// a string.
+func (ent *Entry) Strs(key string) ([]string, KeyStatus) {
+	ent.t.Helper()
+	itfs, status := ent.Slice(key)
+	if status != KeyFound {
+		return nil, status
+	}
+	got := make([]string, len(itfs))
+	for i, itf := range itfs {
+		str, ok := itf.(string)
+		if !ok {
+			return nil, KeyBadType
+		}
+		got[i] = str
+	}
+	return got, KeyFound
+}
+
+// Nums returns log entry field key as a slice of float64 values. It returns
+// KeyBadType if the field is not an array or any of its elements is not
+// a number.
+func (ent *Entry) Nums(key string) ([]float64, KeyStatus) {
+	ent.t.Helper()
+	itfs, status := ent.Slice(key)
+	if status != KeyFound {
+		return nil, status
+	}
+	got := make([]float64, len(itfs))
+	for i, itf := range itfs {
+		num, ok := itf.(float64)
+		if !ok {
+			return nil, KeyBadType
+		}
+		got[i] = num
+	}
+	return got, KeyFound
+}
+
+// ExpStrs tests log entry has a field key, its value is an array of strings,
+// and it's equal to exp.
+func (ent *Entry) ExpStrs(key string, exp []string) {
+	ent.t.Helper()
+	if err := ent.expStrs(key, exp); err != "" {
+		ent.t.Error(err)
+	}
+}
+
+func (ent *Entry) expStrs(key string, exp []string) string {
+	ent.t.Helper()
+	got, status := ent.Strs(key)
+	if status == KeyFound {
+		if !equalKeys(got, exp) {
+			return fmt.Sprintf(
+				"expected entry key '%s' to have value '%s' but got '%s'",
+				key,
+				formatValue(exp),
+				formatValue(got),
+			)
+		}
+		return ""
+	}
+	return formatError(ent.t, status, key, "array of strings")
+}
+
+// ExpArrayLen tests log entry has a field key, its value is an array,
+// and it has exp elements.
+func (ent *Entry) ExpArrayLen(key string, exp int) {
+	ent.t.Helper()
+	if err := ent.expArrayLen(key, exp); err != "" {
+		ent.t.Error(err)
+	}
+}
+
+func (ent *Entry) expArrayLen(key string, exp int) string {
+	ent.t.Helper()
+	got, status := ent.Slice(key)
+	if status == KeyFound {
+		if len(got) != exp {
+			return fmt.Sprintf(
+				"expected entry key '%s' to have %d elements but got %d",
+				key,
+				exp,
+				len(got),
+			)
+		}
+		return ""
+	}
+	return formatError(ent.t, status, key, "array")
+}
+
+// ExpArrayContains tests log entry has a field key, its value is an array,
+// and at least one of its elements is equal to exp. The exp is compared
+// after JSON round trip, so for example int 1 matches JSON number 1.
+func (ent *Entry) ExpArrayContains(key string, exp interface{}) {
+	ent.t.Helper()
+	if err := ent.expArrayContains(key, exp); err != "" {
+		ent.t.Error(err)
+	}
+}
+
+func (ent *Entry) expArrayContains(key string, exp interface{}) string {
+	ent.t.Helper()
+	got, status := ent.Slice(key)
+	if status == KeyFound {
+		norm, err := normalizeValue(exp)
+		if err != nil {
+			return err.Error()
+		}
+		for _, itf := range got {
+			if reflect.DeepEqual(itf, norm) {
+				return ""
+			}
+		}
+		return fmt.Sprintf(
+			"expected entry key '%s' to contain '%s' but got '%s'",
+			key,
+			formatValue(norm),
+			formatValue(got),
+		)
+	}
+	return formatError(ent.t, status, key, "array")
+}
+
+// IP returns log entry field key as net.IP. The value is expected to be
+// a string in the format written by zerolog Event.IPAddr method.
+func (ent *Entry) IP(key string) (net.IP, KeyStatus) {
+	ent.t.Helper()
+	str, status := ent.Str(key)
+	if status != KeyFound {
+		return nil, status
+	}
+	ip := net.ParseIP(str)
+	if ip == nil {
+		return nil, KeyBadFormat
+	}
+	return ip, KeyFound
+}
+
+// ExpIP tests log entry has a field key, its value is a string
+// representing IP address and it's equal to exp.
+func (ent *Entry) ExpIP(key string, exp net.IP) {
+	ent.t.Helper()
+	got, status := ent.IP(key)
+	if status == KeyFound {
+		if !got.Equal(exp) {
+			ent.t.Errorf(
+				"expected entry key '%s' to have value '%s' but got '%s'",
+				key,
+				exp.String(),
+				got.String(),
+			)
+		}
+		return
+	}
+	ent.t.Error(formatError(ent.t, status, key, "string"))
+}
+
+// IPNet returns log entry field key as net.IPNet. The value is expected to
+// be a string in the format written by zerolog Event.IPPrefix method.
+// The IP part is returned as it was logged, it's not masked.
+func (ent *Entry) IPNet(key string) (net.IPNet, KeyStatus) {
+	ent.t.Helper()
+	str, status := ent.Str(key)
+	if status != KeyFound {
+		return net.IPNet{}, status
+	}
+	ip, ipn, err := net.ParseCIDR(str)
+	if err != nil {
+		return net.IPNet{}, KeyBadFormat
+	}
+	if ip4 := ip.To4(); ip4 != nil && len(ipn.IP) == net.IPv4len {
+		ip = ip4
+	}
+	return net.IPNet{IP: ip, Mask: ipn.Mask}, KeyFound
+}
+
+// ExpIPNet tests log entry has a field key, its value is a string
+// representing IP network and it's equal to exp.
+func (ent *Entry) ExpIPNet(key string, exp net.IPNet) {
+	ent.t.Helper()
+	got, status := ent.IPNet(key)
+	if status == KeyFound {
+		if got.String() != exp.String() {
+			ent.t.Errorf(
+				"expected entry key '%s' to have value '%s' but got '%s'",
+				key,
+				exp.String(),
+				got.String(),
+			)
+		}
+		return
+	}
+	ent.t.Error(formatError(ent.t, status, key, "string"))
+}
+
+// MAC returns log entry field key as net.HardwareAddr. The value is
+// expected to be a string in the format written by zerolog Event.MACAddr
+// method.
+func (ent *Entry) MAC(key string) (net.HardwareAddr, KeyStatus) {
+	ent.t.Helper()
+	str, status := ent.Str(key)
+	if status != KeyFound {
+		return nil, status
+	}
+	mac, err := net.ParseMAC(str)
+	if err != nil {
+		return nil, KeyBadFormat
+	}
+	return mac, KeyFound
+}
+
+// ExpMAC tests log entry has a field key, its value is a string
+// representing MAC address and it's equal to exp.
+func (ent *Entry) ExpMAC(key string, exp net.HardwareAddr) {
+	ent.t.Helper()
+	got, status := ent.MAC(key)
+	if status == KeyFound {
+		if !bytes.Equal(got, exp) {
+			ent.t.Errorf(
+				"expected entry key '%s' to have value '%s' but got '%s'",
+				key,
+				exp.String(),
+				got.String(),
+			)
+		}
+		return
+	}
+	ent.t.Error(formatError(ent.t, status, key, "string"))
+}
+
+// HexBytes returns log entry field key as a byte slice. The value is
+// expected to be a string in the format written by zerolog Event.Hex method.
+func (ent *Entry) HexBytes(key string) ([]byte, KeyStatus) {
+	ent.t.Helper()
+	str, status := ent.Str(key)
+	if status != KeyFound {
+		return nil, status
+	}
+	got, err := hex.DecodeString(str)
+	if err != nil {
+		return nil, KeyBadFormat
+	}
+	return got, KeyFound
+}
+
+// ExpHexBytes tests log entry has a field key, its value is a hex encoded
+// string and decoded bytes are equal to exp.
+func (ent *Entry) ExpHexBytes(key string, exp []byte) {
+	ent.t.Helper()
+	got, status := ent.HexBytes(key)
+	if status == KeyFound {
+		if !bytes.Equal(got, exp) {
+			ent.t.Errorf(
+				"expected entry key '%s' to have value '%s' but got '%s'",
+				key,
+				hex.EncodeToString(exp),
+				hex.EncodeToString(got),
+			)
+		}
+		return
+	}
+	ent.t.Error(formatError(ent.t, status, key, "string"))
+}
+
+// ExpBytes tests log entry has a field key, its value is a string and it's
+// equal to exp. It's a counterpart of zerolog Event.Bytes method.
+func (ent *Entry) ExpBytes(key string, exp []byte) {
+	ent.t.Helper()
+	ent.ExpStr(key, string(exp))
+}
+
+// Caller returns file and line from the zerolog.CallerFieldName field. The
+// field value is parsed with CallerUnmarshalFunc.
+func (ent *Entry) Caller() (file string, line int, status KeyStatus) {
+	ent.t.Helper()
+	_, file, line, status = ent.caller()
+	return file, line, status
+}
+
+// caller returns function, file and line from the zerolog.CallerFieldName
+// field.
+func (ent *Entry) caller() (fn, file string, line int, status KeyStatus) {
+	ent.t.Helper()
+	str, status := ent.Str(zerolog.CallerFieldName)
+	if status != KeyFound {
+		return "", "", 0, status
+	}
+	fn, file, line, err := CallerUnmarshalFunc(str)
+	if err != nil {
+		return "", "", 0, KeyBadFormat
+	}
+	return fn, file, line, KeyFound
+}
+
+// ExpCallerFile tests log entry caller field (zerolog.CallerFieldName) has
+// file path ending with suffix.
+func (ent *Entry) ExpCallerFile(suffix string) {
+	ent.t.Helper()
+	_, file, _, status := ent.caller()
+	if status == KeyFound {
+		if !strings.HasSuffix(file, suffix) {
+			ent.t.Errorf(
+				"expected entry caller file to end with '%s' but got '%s'",
+				suffix,
+				file,
+			)
+		}
+		return
+	}
+	ent.t.Error(formatError(ent.t, status, zerolog.CallerFieldName, "string"))
+}
+
+// ExpCallerFunc tests log entry caller field (zerolog.CallerFieldName) has
+// function name ending with suffix. The default zerolog.CallerMarshalFunc
+// doesn't write function names, so it requires a custom marshal function
+// writing the value in "file:line func" form or replacing
+// CallerUnmarshalFunc with a parser for the used format.
+func (ent *Entry) ExpCallerFunc(suffix string) {
+	ent.t.Helper()
+	fn, _, _, status := ent.caller()
+	if status == KeyFound {
+		if fn == "" || !strings.HasSuffix(fn, suffix) {
+			ent.t.Errorf(
+				"expected entry caller function to end with '%s' but got '%s'",
+				suffix,
+				fn,
+			)
+		}
+		return
+	}
+	ent.t.Error(formatError(ent.t, status, zerolog.CallerFieldName, "string"))
+}
+
+// ExpCallerThisFile tests log entry caller field (zerolog.CallerFieldName)
+// points to the file ExpCallerThisFile is called from. The file path is
+// formatted with zerolog.CallerMarshalFunc and parsed with
+// CallerUnmarshalFunc before the comparison, so custom marshal functions
+// shortening the path are respected.
+func (ent *Entry) ExpCallerThisFile() {
+	ent.t.Helper()
+	_, file, line, _ := runtime.Caller(1)
+	ent.expCallerIn(callerFile(file, line))
+}
+
+// callerFile returns file path as it's written to the caller field, that is
+// after the round trip through zerolog.CallerMarshalFunc and
+// CallerUnmarshalFunc. Returns file when it can't be parsed back.
+func callerFile(file string, line int) string {
+	_, got, _, err := CallerUnmarshalFunc(zerolog.CallerMarshalFunc(file, line))
+	if err != nil {
+		return file
+	}
+	return got
+}
+
+// expCallerIn tests log entry caller field (zerolog.CallerFieldName) points
+// to the exp file.
+func (ent *Entry) expCallerIn(exp string) {
+	ent.t.Helper()
+	_, file, _, status := ent.caller()
+	if status == KeyFound {
+		if file != exp {
+			ent.t.Errorf(
+				"expected entry caller file to be '%s' but got '%s'",
+				exp,
+				file,
+			)
+		}
+		return
+	}
+	ent.t.Error(formatError(ent.t, status, zerolog.CallerFieldName, "string"))
+}
+
+// Stack returns stack trace frames from the zerolog.ErrorStackFieldName
+// field. The frames are expected in the format written by
+// pkgerrors.MarshalStack. It returns KeyBadFormat if any of the frames is
+// not an object or its line number is not a number.
+func (ent *Entry) Stack() ([]Frame, KeyStatus) {
+	ent.t.Helper()
+	itfs, status := ent.Slice(zerolog.ErrorStackFieldName)
+	if status != KeyFound {
+		return nil, status
+	}
+
+	frames := make([]Frame, 0, len(itfs))
+	for _, itf := range itfs {
+		m, ok := itf.(map[string]interface{})
+		if !ok {
+			return nil, KeyBadFormat
+		}
+		frm := Frame{}
+		frm.Func, _ = m["func"].(string)
+		frm.Source, _ = m["source"].(string)
+		switch line := m["line"].(type) {
+		case string:
+			n, err := strconv.Atoi(line)
+			if err != nil {
+				return nil, KeyBadFormat
+			}
+			frm.Line = n
+		case float64:
+			frm.Line = int(line)
+		case nil:
+		default:
+			return nil, KeyBadFormat
+		}
+		frames = append(frames, frm)
+	}
+	return frames, KeyFound
+}
+
+// ExpStack tests log entry has a non-empty stack trace field
+// (zerolog.ErrorStackFieldName).
+func (ent *Entry) ExpStack() {
+	ent.t.Helper()
+	frames, status := ent.Stack()
+	if status == KeyFound {
+		if len(frames) == 0 {
+			ent.t.Errorf("expected entry key '%s' to have stack frames", zerolog.ErrorStackFieldName)
+		}
+		return
+	}
+	ent.t.Error(formatError(ent.t, status, zerolog.ErrorStackFieldName, "array"))
+}
+
+// ExpStackContainsFunc tests log entry has a stack trace field
+// (zerolog.ErrorStackFieldName) with at least one frame whose function name
+// ends with suffix.
+func (ent *Entry) ExpStackContainsFunc(suffix string) {
+	ent.t.Helper()
+	frames, status := ent.Stack()
+	if status == KeyFound {
+		for _, frm := range frames {
+			if strings.HasSuffix(frm.Func, suffix) {
+				return
+			}
+		}
+		ent.t.Errorf(
+			"expected entry key '%s' to have frame with function '%s'",
+			zerolog.ErrorStackFieldName,
+			suffix,
+		)
+		return
+	}
+	ent.t.Error(formatError(ent.t, status, zerolog.ErrorStackFieldName, "array"))
+}
+
+// ExpNoStack tests log entry has no stack trace field
+// (zerolog.ErrorStackFieldName).
+func (ent *Entry) ExpNoStack() {
+	ent.t.Helper()
+	ent.NotExpKey(zerolog.ErrorStackFieldName)
+}
+
 // formatError formats error message based on status of log entry key search.
 func formatError(t T, status KeyStatus, key, typ string) string {
 	t.Helper()
@@ -359,3 +1127,101 @@ func formatError(t T, status KeyStatus, key, typ string) string {
 		return fmt.Sprintf("invalid KeyStatus '%s'", status)
 	}
 }
+
+// keysDiff returns keys present in exp but not in have (missing) and keys
+// present in have but not in exp (unexpected).
+func keysDiff(exp, have []string) (missing, unexpected []string) {
+	expSet := make(map[string]bool, len(exp))
+	for _, key := range exp {
+		expSet[key] = true
+	}
+	haveSet := make(map[string]bool, len(have))
+	for _, key := range have {
+		haveSet[key] = true
+	}
+
+	missing = make([]string, 0)
+	for _, key := range exp {
+		if !haveSet[key] {
+			missing = append(missing, key)
+			haveSet[key] = true // Report each key once.
+		}
+	}
+	unexpected = make([]string, 0)
+	for _, key := range have {
+		if !expSet[key] {
+			unexpected = append(unexpected, key)
+			expSet[key] = true // Report each key once.
+		}
+	}
+	return missing, unexpected
+}
+
+// equalKeys returns true if both key lists are the same.
+func equalKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// joinKeys formats list of keys for error messages.
+func joinKeys(keys []string) string {
+	if len(keys) == 0 {
+		return "[]"
+	}
+	return "['" + strings.Join(keys, "', '") + "']"
+}
+
+// field represents log entry field as it was written to the writer.
+type field struct {
+	key string          // Field key.
+	val json.RawMessage // JSON encoded field value.
+}
+
+// entryFields returns top level fields of JSON object in the order they
+// appear in the raw log entry, including duplicates.
+func entryFields(raw []byte) ([]field, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+
+	fields := make([]field, 0)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected object key but got '%v'", tok)
+		}
+
+		var val json.RawMessage
+		if err := dec.Decode(&val); err != nil {
+			return nil, err
+		}
+		fields = append(fields, field{key: key, val: val})
+	}
+	return fields, nil
+}
+
+// entryKeys returns top level keys of JSON object in the order they appear
+// in the raw log entry, including duplicates.
+func entryKeys(raw []byte) ([]string, error) {
+	fields, err := entryFields(raw)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(fields))
+	for i, fld := range fields {
+		keys[i] = fld.key
+	}
+	return keys, nil
+}
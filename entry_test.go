@@ -3,6 +3,11 @@ package zltest
 import (
 	"errors"
 	"fmt"
+	"net"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -121,6 +126,159 @@ func Test_Entry_NotExpKey_error(t *testing.T) {
 	mck.AssertExpectations(t)
 }
 
+func Test_Entry_Keys(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().Str("k1", "v1").Str("k0", "v0").Str("k1", "v2").Msg("msg")
+
+	// --- When ---
+	got := tst.LastEntry().Keys()
+
+	// --- Then ---
+	exp := []string{"level", "k1", "k0", "k1", "message"}
+	assert.Exactly(t, exp, got)
+}
+
+func Test_Entry_ExpNoDuplicateKeys(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().Str("k0", "v0").Dict("k1", zerolog.Dict().Str("k0", "v1")).Send()
+
+	// --- Then ---
+	tst.LastEntry().ExpNoDuplicateKeys()
+}
+
+func Test_Entry_ExpNoDuplicateKeys_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry to have no duplicate keys but got '%s' in:\n%s",
+		"user', 'level",
+		`  {"level":"error","user":"a","user":"b","level":"info"}`,
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Str("user", "a").Str("user", "b").Str("level", "info").Send()
+
+	// --- When ---
+	tst.LastEntry().ExpNoDuplicateKeys()
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpKeys(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().Str("svc", "api").Msg("msg")
+
+	// --- Then ---
+	tst.LastEntry().ExpKeys("message", "svc", "level")
+}
+
+func Test_Entry_ExpKeys_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry keys to be %s but missing %s and unexpected %s",
+		"['level', 'service', 'message']",
+		"['service']",
+		"['svc']",
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Str("svc", "api").Msg("msg")
+
+	// --- When ---
+	tst.LastEntry().ExpKeys("level", "service", "message")
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpKeysSubset(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().Str("svc", "api").Msg("msg")
+
+	// --- Then ---
+	tst.LastEntry().ExpKeysSubset("svc", "level")
+}
+
+func Test_Entry_ExpKeysSubset_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry to have keys %s but missing %s",
+		"['svc', 'user']",
+		"['user']",
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Str("svc", "api").Msg("msg")
+
+	// --- When ---
+	tst.LastEntry().ExpKeysSubset("svc", "user")
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpKeysOrdered(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().Str("svc", "api").Msg("msg")
+
+	// --- Then ---
+	tst.LastEntry().ExpKeysOrdered("level", "svc", "message")
+}
+
+func Test_Entry_ExpKeysOrdered_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry keys in order %s but got %s (missing %s, unexpected %s)",
+		"['svc', 'level', 'message']",
+		"['level', 'svc', 'message']",
+		"[]",
+		"[]",
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Str("svc", "api").Msg("msg")
+
+	// --- When ---
+	tst.LastEntry().ExpKeysOrdered("svc", "level", "message")
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
 func Test_Entry_Str(t *testing.T) {
 	tst := New(t)
 	log := zerolog.New(tst)
@@ -880,6 +1038,971 @@ func Test_Entry_Map_error(t *testing.T) {
 	}
 }
 
+func Test_Entry_Decode(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().Str("str", "val").Int("int", 1).Msg("msg")
+
+	// --- When ---
+	got := struct {
+		Str string `json:"str"`
+		Int int    `json:"int"`
+		Msg string `json:"message"`
+	}{}
+	tst.LastEntry().Decode(&got)
+
+	// --- Then ---
+	assert.Exactly(t, "val", got.Str)
+	assert.Exactly(t, 1, got.Int)
+	assert.Exactly(t, "msg", got.Msg)
+}
+
+func Test_Entry_Decode_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Fatal", mock.AnythingOfType("*json.UnmarshalTypeError"))
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Str("int", "val").Send()
+
+	// --- When ---
+	got := struct {
+		Int int `json:"int"`
+	}{}
+	tst.LastEntry().Decode(&got)
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_DecodeField(t *testing.T) {
+	type user struct {
+		Name string   `json:"name"`
+		Tags []string `json:"tags"`
+	}
+
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().
+		Interface("user", user{Name: "bob", Tags: []string{"a", "b"}}).
+		RawJSON("raw", []byte(`{"name":"alice"}`)).
+		Str("str", "val").
+		Send()
+
+	tt := []struct {
+		testN string
+
+		key    string
+		expVal user
+		expSt  KeyStatus
+	}{
+		{"1", "user", user{Name: "bob", Tags: []string{"a", "b"}}, KeyFound},
+		{"2", "raw", user{Name: "alice"}, KeyFound},
+		{"3", "str", user{}, KeyBadType},
+		{"4", "missing", user{}, KeyMissing},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			var got user
+			st := tst.LastEntry().DecodeField(tc.key, &got)
+
+			// --- Then ---
+			assert.Equal(t, tc.expVal, got, "test %s", tc.testN)
+			assert.Exactly(t, tc.expSt, st, "test %s", tc.testN)
+		})
+	}
+}
+
+func Test_Entry_Slice(t *testing.T) {
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().
+		Strs("strs", []string{"a", "b"}).
+		Ints("ints", []int{1, 2}).
+		Interface("mix", []interface{}{"a", 1}).
+		Str("str", "val").
+		Send()
+
+	tt := []struct {
+		testN string
+
+		key   string
+		expSl []interface{}
+		expSt KeyStatus
+		expS  []string
+		expSS KeyStatus
+		expN  []float64
+		expNS KeyStatus
+	}{
+		{"1", "strs", []interface{}{"a", "b"}, KeyFound, []string{"a", "b"}, KeyFound, nil, KeyBadType},
+		{"2", "ints", []interface{}{1.0, 2.0}, KeyFound, nil, KeyBadType, []float64{1, 2}, KeyFound},
+		{"3", "mix", []interface{}{"a", 1.0}, KeyFound, nil, KeyBadType, nil, KeyBadType},
+		{"4", "str", nil, KeyBadType, nil, KeyBadType, nil, KeyBadType},
+		{"5", "missing", nil, KeyMissing, nil, KeyMissing, nil, KeyMissing},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			sl, slSt := tst.LastEntry().Slice(tc.key)
+			strs, strsSt := tst.LastEntry().Strs(tc.key)
+			nums, numsSt := tst.LastEntry().Nums(tc.key)
+
+			// --- Then ---
+			assert.Exactly(t, tc.expSl, sl, "test %s", tc.testN)
+			assert.Exactly(t, tc.expSt, slSt, "test %s", tc.testN)
+			assert.Exactly(t, tc.expS, strs, "test %s", tc.testN)
+			assert.Exactly(t, tc.expSS, strsSt, "test %s", tc.testN)
+			assert.Exactly(t, tc.expN, nums, "test %s", tc.testN)
+			assert.Exactly(t, tc.expNS, numsSt, "test %s", tc.testN)
+		})
+	}
+}
+
+func Test_Entry_ExpStrs(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().Strs("strs", []string{"a", "b"}).Send()
+
+	// --- Then ---
+	tst.LastEntry().ExpStrs("strs", []string{"a", "b"})
+}
+
+func Test_Entry_ExpStrs_notEqual(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Error", `expected entry key 'strs' to have value '["a"]' but got '["a","b"]'`)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Strs("strs", []string{"a", "b"}).Send()
+
+	// --- When ---
+	tst.LastEntry().ExpStrs("strs", []string{"a"})
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpArrayLen(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().Errs("errs", []error{errors.New("e0"), errors.New("e1")}).Send()
+
+	// --- Then ---
+	tst.LastEntry().ExpArrayLen("errs", 2)
+}
+
+func Test_Entry_ExpArrayLen_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Error", "expected entry key 'ints' to have 3 elements but got 2")
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Ints("ints", []int{1, 2}).Send()
+
+	// --- When ---
+	tst.LastEntry().ExpArrayLen("ints", 3)
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpArrayLen_notArray(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Error", "expected entry key 'str' to be 'array'")
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Str("str", "val").Send()
+
+	// --- When ---
+	tst.LastEntry().ExpArrayLen("str", 3)
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpArrayContains(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().
+		Ints("ints", []int{1, 2}).
+		Array("arr", zerolog.Arr().Str("a").Interface(map[string]int{"k": 1})).
+		Send()
+
+	// --- Then ---
+	tst.LastEntry().ExpArrayContains("ints", 2)
+	tst.LastEntry().ExpArrayContains("arr", "a")
+	tst.LastEntry().ExpArrayContains("arr", map[string]int{"k": 1})
+}
+
+func Test_Entry_ExpArrayContains_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Error", "expected entry key 'ints' to contain '3' but got '[1,2]'")
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Ints("ints", []int{1, 2}).Send()
+
+	// --- When ---
+	tst.LastEntry().ExpArrayContains("ints", 3)
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_IP(t *testing.T) {
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().
+		IPAddr("ip4", net.IPv4(192, 168, 0, 1)).
+		IPAddr("ip6", net.ParseIP("2001:db8::1")).
+		Str("bad", "300.1.1.1").
+		Int("int", 1).
+		Send()
+
+	tt := []struct {
+		testN string
+
+		key    string
+		expVal net.IP
+		expSt  KeyStatus
+	}{
+		{"1", "ip4", net.ParseIP("192.168.0.1"), KeyFound},
+		{"2", "ip6", net.ParseIP("2001:db8::1"), KeyFound},
+		{"3", "bad", nil, KeyBadFormat},
+		{"4", "int", nil, KeyBadType},
+		{"5", "missing", nil, KeyMissing},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			val, st := tst.LastEntry().IP(tc.key)
+
+			// --- Then ---
+			assert.Exactly(t, tc.expVal, val, "test %s", tc.testN)
+			assert.Exactly(t, tc.expSt, st, "test %s", tc.testN)
+		})
+	}
+}
+
+func Test_Entry_ExpIP(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().IPAddr("ip", net.IPv4(192, 168, 0, 1)).Send()
+
+	// --- Then ---
+	tst.LastEntry().ExpIP("ip", net.IPv4(192, 168, 0, 1).To4())
+}
+
+func Test_Entry_ExpIP_notEqual(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry key '%s' to have value '%s' but got '%s'",
+		"ip",
+		"10.0.0.1",
+		"192.168.0.1",
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().IPAddr("ip", net.IPv4(192, 168, 0, 1)).Send()
+
+	// --- When ---
+	tst.LastEntry().ExpIP("ip", net.IPv4(10, 0, 0, 1))
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpIP_badFormat(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Error", "key 'ip' in a wrong format")
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Str("ip", "abc").Send()
+
+	// --- When ---
+	tst.LastEntry().ExpIP("ip", net.IPv4(10, 0, 0, 1))
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_IPNet(t *testing.T) {
+	tst := New(t)
+	log := zerolog.New(tst)
+	_, ipn4, _ := net.ParseCIDR("192.168.0.0/24")
+	_, ipn6, _ := net.ParseCIDR("2001:db8::/32")
+	log.Error().
+		IPPrefix("ipn4", *ipn4).
+		IPPrefix("ipn6", *ipn6).
+		Str("bad", "192.168.0.0").
+		Int("int", 1).
+		Send()
+
+	tt := []struct {
+		testN string
+
+		key    string
+		expVal string
+		expSt  KeyStatus
+	}{
+		{"1", "ipn4", "192.168.0.0/24", KeyFound},
+		{"2", "ipn6", "2001:db8::/32", KeyFound},
+		{"3", "bad", "<nil>", KeyBadFormat},
+		{"4", "int", "<nil>", KeyBadType},
+		{"5", "missing", "<nil>", KeyMissing},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			val, st := tst.LastEntry().IPNet(tc.key)
+
+			// --- Then ---
+			assert.Exactly(t, tc.expVal, val.String(), "test %s", tc.testN)
+			assert.Exactly(t, tc.expSt, st, "test %s", tc.testN)
+		})
+	}
+}
+
+func Test_Entry_ExpIPNet(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+	_, ipn, _ := net.ParseCIDR("10.0.0.0/8")
+
+	// --- When ---
+	log.Error().IPPrefix("ipn", *ipn).Send()
+
+	// --- Then ---
+	tst.LastEntry().ExpIPNet("ipn", *ipn)
+}
+
+func Test_Entry_MAC(t *testing.T) {
+	tst := New(t)
+	log := zerolog.New(tst)
+	mac, _ := net.ParseMAC("00:1a:2b:3c:4d:5e")
+	log.Error().
+		MACAddr("mac", mac).
+		Str("bad", "00:1a").
+		Int("int", 1).
+		Send()
+
+	tt := []struct {
+		testN string
+
+		key    string
+		expVal net.HardwareAddr
+		expSt  KeyStatus
+	}{
+		{"1", "mac", mac, KeyFound},
+		{"2", "bad", nil, KeyBadFormat},
+		{"3", "int", nil, KeyBadType},
+		{"4", "missing", nil, KeyMissing},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			val, st := tst.LastEntry().MAC(tc.key)
+
+			// --- Then ---
+			assert.Exactly(t, tc.expVal, val, "test %s", tc.testN)
+			assert.Exactly(t, tc.expSt, st, "test %s", tc.testN)
+		})
+	}
+}
+
+func Test_Entry_ExpMAC_notEqual(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry key '%s' to have value '%s' but got '%s'",
+		"mac",
+		"00:00:00:00:00:01",
+		"00:1a:2b:3c:4d:5e",
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	mac, _ := net.ParseMAC("00:1a:2b:3c:4d:5e")
+	log.Error().MACAddr("mac", mac).Send()
+
+	// --- When ---
+	exp, _ := net.ParseMAC("00:00:00:00:00:01")
+	tst.LastEntry().ExpMAC("mac", exp)
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_HexBytes(t *testing.T) {
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().
+		Hex("hex", []byte{0xde, 0xad}).
+		Str("bad", "xyz").
+		Int("int", 1).
+		Send()
+
+	tt := []struct {
+		testN string
+
+		key    string
+		expVal []byte
+		expSt  KeyStatus
+	}{
+		{"1", "hex", []byte{0xde, 0xad}, KeyFound},
+		{"2", "bad", nil, KeyBadFormat},
+		{"3", "int", nil, KeyBadType},
+		{"4", "missing", nil, KeyMissing},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			val, st := tst.LastEntry().HexBytes(tc.key)
+
+			// --- Then ---
+			assert.Exactly(t, tc.expVal, val, "test %s", tc.testN)
+			assert.Exactly(t, tc.expSt, st, "test %s", tc.testN)
+		})
+	}
+}
+
+func Test_Entry_ExpHexBytes_notEqual(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry key '%s' to have value '%s' but got '%s'",
+		"hex",
+		"beef",
+		"dead",
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Hex("hex", []byte{0xde, 0xad}).Send()
+
+	// --- When ---
+	tst.LastEntry().ExpHexBytes("hex", []byte{0xbe, 0xef})
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpBytes(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().Bytes("bytes", []byte("abc")).Send()
+
+	// --- Then ---
+	tst.LastEntry().ExpBytes("bytes", []byte("abc"))
+}
+
+func Test_Entry_Caller(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst).With().Caller().Logger()
+	_, file, line, _ := runtime.Caller(0)
+	log.Error().Send()
+
+	// --- When ---
+	gotFile, gotLine, st := tst.LastEntry().Caller()
+
+	// --- Then ---
+	assert.Exactly(t, KeyFound, st)
+	assert.Exactly(t, file, gotFile)
+	assert.Exactly(t, line+1, gotLine)
+}
+
+func Test_Entry_Caller_errors(t *testing.T) {
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().Send()
+	log.Error().Int(zerolog.CallerFieldName, 1).Send()
+	log.Error().Str(zerolog.CallerFieldName, "file.go").Send()
+	log.Error().Str(zerolog.CallerFieldName, "file.go:abc").Send()
+
+	tt := []struct {
+		testN string
+
+		n     int
+		expSt KeyStatus
+	}{
+		{"1", 0, KeyMissing},
+		{"2", 1, KeyBadType},
+		{"3", 2, KeyBadFormat},
+		{"4", 3, KeyBadFormat},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			file, line, st := tst.Entries().ExpEntry(tc.n).Caller()
+
+			// --- Then ---
+			assert.Exactly(t, "", file, "test %s", tc.testN)
+			assert.Exactly(t, 0, line, "test %s", tc.testN)
+			assert.Exactly(t, tc.expSt, st, "test %s", tc.testN)
+		})
+	}
+}
+
+func Test_Entry_ExpCallerFile(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst).With().Caller().Logger()
+
+	// --- When ---
+	log.Error().Send()
+
+	// --- Then ---
+	tst.LastEntry().ExpCallerFile("entry_test.go")
+	tst.LastEntry().ExpCallerThisFile()
+}
+
+func Test_Entry_ExpCallerFile_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry caller file to end with '%s' but got '%s'",
+		"other.go",
+		"/a/file.go",
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Str(zerolog.CallerFieldName, "/a/file.go:12").Send()
+
+	// --- When ---
+	tst.LastEntry().ExpCallerFile("other.go")
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpCallerThisFile_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	_, file, _, _ := runtime.Caller(0)
+	mck.On(
+		"Errorf",
+		"expected entry caller file to be '%s' but got '%s'",
+		file,
+		"/a/file.go",
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Str(zerolog.CallerFieldName, "/a/file.go:12").Send()
+
+	// --- When ---
+	tst.LastEntry().ExpCallerThisFile()
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpCallerFunc(t *testing.T) {
+	// --- Given ---
+	defer func(m func(string, int) string) { zerolog.CallerMarshalFunc = m }(zerolog.CallerMarshalFunc)
+	defer func(u func(string) (string, string, int, error)) { CallerUnmarshalFunc = u }(CallerUnmarshalFunc)
+
+	zerolog.CallerMarshalFunc = func(file string, line int) string {
+		return "myFunc@" + file + ":" + strconv.Itoa(line)
+	}
+	CallerUnmarshalFunc = func(caller string) (string, string, int, error) {
+		parts := strings.SplitN(caller, "@", 2)
+		idx := strings.LastIndex(parts[1], ":")
+		line, err := strconv.Atoi(parts[1][idx+1:])
+		return parts[0], parts[1][:idx], line, err
+	}
+
+	tst := New(t)
+	log := zerolog.New(tst).With().Caller().Logger()
+
+	// --- When ---
+	log.Error().Send()
+
+	// --- Then ---
+	tst.LastEntry().ExpCallerFunc("Func")
+	tst.LastEntry().ExpCallerThisFile()
+}
+
+func Test_Entry_ExpCallerThisFile_customMarshal(t *testing.T) {
+	// --- Given ---
+	defer func(m func(string, int) string) { zerolog.CallerMarshalFunc = m }(zerolog.CallerMarshalFunc)
+	zerolog.CallerMarshalFunc = func(file string, line int) string {
+		return filepath.Base(file) + ":" + strconv.Itoa(line)
+	}
+
+	tst := New(t)
+	log := zerolog.New(tst).With().Caller().Logger()
+
+	// --- When ---
+	log.Error().Send()
+
+	// --- Then ---
+	tst.LastEntry().ExpCallerThisFile()
+	assert.True(t, tst.LastEntry().HasCallerThisFile())
+}
+
+func Test_Entry_ExpCallerFunc_defaultUnmarshal(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().Str(zerolog.CallerFieldName, "/a/file.go:12 pkg.(*T).myFunc").Send()
+
+	// --- Then ---
+	ent := tst.LastEntry()
+	ent.ExpCallerFunc("myFunc")
+	ent.ExpCallerFile("/a/file.go")
+	file, line, status := ent.Caller()
+	assert.Exactly(t, KeyFound, status)
+	assert.Exactly(t, "/a/file.go", file)
+	assert.Exactly(t, 12, line)
+}
+
+func Test_Entry_ExpCallerFunc_noFunc(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry caller function to end with '%s' but got '%s'",
+		"myFunc",
+		"",
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Str(zerolog.CallerFieldName, "/a/file.go:12").Send()
+
+	// --- When ---
+	tst.LastEntry().ExpCallerFunc("myFunc")
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+// testStackMarshaler returns stack trace in the format written by
+// pkgerrors.MarshalStack.
+func testStackMarshaler(err error) interface{} {
+	return []map[string]string{
+		{"source": "main.go", "line": "10", "func": "main"},
+		{"source": "svc.go", "line": "42", "func": "(*Service).Run"},
+	}
+}
+
+func Test_Entry_Stack(t *testing.T) {
+	// --- Given ---
+	defer func(m func(error) interface{}) { zerolog.ErrorStackMarshaler = m }(zerolog.ErrorStackMarshaler)
+	zerolog.ErrorStackMarshaler = testStackMarshaler
+
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().Stack().Err(errors.New("test")).Send()
+
+	// --- When ---
+	got, st := tst.LastEntry().Stack()
+
+	// --- Then ---
+	exp := []Frame{
+		{Func: "main", Source: "main.go", Line: 10},
+		{Func: "(*Service).Run", Source: "svc.go", Line: 42},
+	}
+	assert.Exactly(t, KeyFound, st)
+	assert.Exactly(t, exp, got)
+	tst.LastEntry().ExpStack()
+	tst.LastEntry().ExpStackContainsFunc("Service).Run")
+}
+
+func Test_Entry_Stack_errors(t *testing.T) {
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().Send()
+	log.Error().Str(zerolog.ErrorStackFieldName, "stack").Send()
+	log.Error().Strs(zerolog.ErrorStackFieldName, []string{"frame"}).Send()
+	log.Error().RawJSON(zerolog.ErrorStackFieldName, []byte(`[{"line":"abc"}]`)).Send()
+
+	tt := []struct {
+		testN string
+
+		n     int
+		expSt KeyStatus
+	}{
+		{"1", 0, KeyMissing},
+		{"2", 1, KeyBadType},
+		{"3", 2, KeyBadFormat},
+		{"4", 3, KeyBadFormat},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			frames, st := tst.Entries().ExpEntry(tc.n).Stack()
+
+			// --- Then ---
+			assert.Nil(t, frames, "test %s", tc.testN)
+			assert.Exactly(t, tc.expSt, st, "test %s", tc.testN)
+		})
+	}
+}
+
+func Test_Entry_ExpStack_missing(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Error", "expected entry to have key 'stack'")
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Err(errors.New("test")).Send()
+
+	// --- When ---
+	tst.LastEntry().ExpStack()
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpStackContainsFunc_error(t *testing.T) {
+	// --- Given ---
+	defer func(m func(error) interface{}) { zerolog.ErrorStackMarshaler = m }(zerolog.ErrorStackMarshaler)
+	zerolog.ErrorStackMarshaler = testStackMarshaler
+
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry key '%s' to have frame with function '%s'",
+		"stack",
+		"other",
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Stack().Err(errors.New("test")).Send()
+
+	// --- When ---
+	tst.LastEntry().ExpStackContainsFunc("other")
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpNoStack(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().Stack().Err(errors.New("test")).Send()
+
+	// --- Then ---
+	tst.LastEntry().ExpNoStack()
+}
+
+func Test_Entry_Level(t *testing.T) {
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Warn().Send()
+	log.Log().Str(zerolog.LevelFieldName, "-3").Send()
+	log.Log().Int(zerolog.LevelFieldName, 4).Send()
+	log.Log().Str(zerolog.LevelFieldName, "bad").Send()
+	log.Log().Bool(zerolog.LevelFieldName, true).Send()
+	log.Log().Send()
+
+	tt := []struct {
+		testN string
+
+		n      int
+		expLvl zerolog.Level
+		expSt  KeyStatus
+	}{
+		{"1", 0, zerolog.WarnLevel, KeyFound},
+		{"2", 1, zerolog.Level(-3), KeyFound},
+		{"3", 2, zerolog.FatalLevel, KeyFound},
+		{"4", 3, zerolog.NoLevel, KeyBadFormat},
+		{"5", 4, zerolog.NoLevel, KeyBadType},
+		{"6", 5, zerolog.NoLevel, KeyMissing},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			lvl, st := tst.Entries().ExpEntry(tc.n).Level()
+
+			// --- Then ---
+			assert.Exactly(t, tc.expLvl, lvl, "test %s", tc.testN)
+			assert.Exactly(t, tc.expSt, st, "test %s", tc.testN)
+		})
+	}
+}
+
+func Test_Entry_Level_customMarshal(t *testing.T) {
+	// --- Given ---
+	defer func(m func(zerolog.Level) string) { zerolog.LevelFieldMarshalFunc = m }(zerolog.LevelFieldMarshalFunc)
+	zerolog.LevelFieldMarshalFunc = func(l zerolog.Level) string {
+		return strings.ToUpper(l.String())
+	}
+
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().Send()
+
+	// --- When ---
+	lvl, st := tst.LastEntry().Level()
+
+	// --- Then ---
+	assert.Exactly(t, zerolog.ErrorLevel, lvl)
+	assert.Exactly(t, KeyFound, st)
+}
+
+func Test_Entry_ExpFields(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Error().
+		Str("str", "val").
+		Int("int", 1).
+		Dict("dict", zerolog.Dict().Bool("bool", true)).
+		Msg("msg")
+
+	// --- Then ---
+	tst.LastEntry().ExpFields(map[string]interface{}{
+		"str":  "val",
+		"int":  1,
+		"dict": map[string]interface{}{"bool": true},
+	})
+}
+
+func Test_Entry_ExpFields_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry fields to match:\n%s",
+		"  key 'int' expected 2 but got 1\n  key 'missing' is missing",
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Str("str", "val").Int("int", 1).Msg("msg")
+
+	// --- When ---
+	tst.LastEntry().ExpFields(map[string]interface{}{
+		"str":     "val",
+		"int":     2,
+		"missing": "val",
+	})
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpEqual(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst).With().Timestamp().Logger()
+
+	// --- When ---
+	log.Error().Str("str", "val").Int("int", 1).Msg("msg")
+
+	// --- Then ---
+	exp := `{"level":"error","str":"val","int":1,"message":"msg"}`
+	tst.LastEntry().ExpEqual(exp, zerolog.TimestampFieldName)
+}
+
+func Test_Entry_ExpEqual_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry to be equal:\n%s",
+		"  key 'int' is unexpected with value 1\n  key 'str' expected \"v\" but got \"val\"",
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst).With().Timestamp().Logger()
+	log.Error().Str("str", "val").Int("int", 1).Msg("msg")
+
+	// --- When ---
+	exp := `{"level":"error","str":"v","message":"msg"}`
+	tst.LastEntry().ExpEqual(exp, zerolog.TimestampFieldName)
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entry_ExpEqual_badJSON(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Fatal", mock.AnythingOfType("*json.SyntaxError"))
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Msg("msg")
+
+	// --- When ---
+	tst.LastEntry().ExpEqual("{ bad json }")
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
 func Test_formatError(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -3,6 +3,9 @@ module github.com/rzajac/zltest
 go 1.15
 
 require (
+	github.com/go-logr/logr v1.2.4
+	github.com/google/go-cmp v0.5.9
+	github.com/onsi/gomega v1.11.0
 	github.com/rs/zerolog v1.25.0
 	github.com/stretchr/testify v1.6.1
 )
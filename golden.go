@@ -0,0 +1,261 @@
+package zltest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// UpdateFlag is the name of the boolean flag which, when set, makes
+// ExpGolden rewrite golden files instead of comparing them. The package
+// doesn't register it, to use it register the flag in your test package:
+//
+//	var _ = flag.Bool(zltest.UpdateFlag, false, "update golden files")
+//
+// and run tests with -zltest.update flag.
+const UpdateFlag = "zltest.update"
+
+// UpdateEnv is the name of the environment variable which, when set to
+// a true value accepted by strconv.ParseBool, makes ExpGolden rewrite golden
+// files instead of comparing them.
+const UpdateEnv = "ZLTEST_UPDATE"
+
+// uuidRx matches UUIDs in their canonical textual representation.
+var uuidRx = regexp.MustCompile(
+	`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`,
+)
+
+// callerLineRx matches line number at the end of the caller field.
+var callerLineRx = regexp.MustCompile(`:\d+"$`)
+
+// unsafePathRx matches characters not allowed in golden file names.
+var unsafePathRx = regexp.MustCompile(`[^a-zA-Z0-9_./-]|\.\.`)
+
+// Scrubber normalizes volatile log entry field value before it's compared
+// with a golden file. It receives field key and its JSON encoded value and
+// returns JSON encoded value to use instead.
+type Scrubber func(key, val string) string
+
+// ScrubKeys returns Scrubber replacing values of fields with given keys
+// with repl string.
+func ScrubKeys(repl string, keys ...string) Scrubber {
+	return func(key, val string) string {
+		for _, k := range keys {
+			if k == key {
+				return jsonString(repl)
+			}
+		}
+		return val
+	}
+}
+
+// ScrubRegexp returns Scrubber replacing all rx matches in every field value
+// with repl. The repl is used as in regexp.Regexp.ReplaceAllString and must
+// keep the value a valid JSON.
+func ScrubRegexp(rx *regexp.Regexp, repl string) Scrubber {
+	return func(_, val string) string {
+		return rx.ReplaceAllString(val, repl)
+	}
+}
+
+// ScrubTimestamp returns Scrubber replacing value of the
+// zerolog.TimestampFieldName field with "<timestamp>".
+func ScrubTimestamp() Scrubber {
+	return func(key, val string) string {
+		if key == zerolog.TimestampFieldName {
+			return `"<timestamp>"`
+		}
+		return val
+	}
+}
+
+// ScrubUUIDs returns Scrubber replacing UUIDs anywhere in field values
+// with "<uuid>".
+func ScrubUUIDs() Scrubber {
+	return ScrubRegexp(uuidRx, "<uuid>")
+}
+
+// ScrubCallerLine returns Scrubber replacing line number in the
+// zerolog.CallerFieldName field with "<line>".
+func ScrubCallerLine() Scrubber {
+	return func(key, val string) string {
+		if key == zerolog.CallerFieldName {
+			return callerLineRx.ReplaceAllString(val, `:<line>"`)
+		}
+		return val
+	}
+}
+
+// ScrubDurations returns Scrubber replacing duration values of fields with
+// given keys with "<dur>". Both numbers and strings accepted by
+// time.ParseDuration are replaced, other values are left as they are.
+// Durations are written by zerolog as numbers in zerolog.DurationFieldUnit
+// units, which can't be told apart from other numbers, so the keys must be
+// listed and DefaultScrubbers doesn't include it.
+func ScrubDurations(keys ...string) Scrubber {
+	return func(key, val string) string {
+		for _, k := range keys {
+			if k != key {
+				continue
+			}
+			var num float64
+			if err := json.Unmarshal([]byte(val), &num); err == nil {
+				return `"<dur>"`
+			}
+			var str string
+			if err := json.Unmarshal([]byte(val), &str); err == nil {
+				if _, err := time.ParseDuration(str); err == nil {
+					return `"<dur>"`
+				}
+			}
+		}
+		return val
+	}
+}
+
+// DefaultScrubbers returns scrubbers used by ExpGolden when none are given.
+func DefaultScrubbers() []Scrubber {
+	return []Scrubber{ScrubTimestamp(), ScrubUUIDs(), ScrubCallerLine()}
+}
+
+// GoldenPath returns golden file path named after the running test in the
+// testdata directory. Subtests are placed in subdirectories named after
+// their parent tests. It calls Fatal when T passed to New doesn't
+// implement TB.
+func (tst *Tester) GoldenPath() string {
+	tst.t.Helper()
+	name := tst.Name()
+	if name == "" {
+		tst.t.Fatal("golden file path requires T implementing zltest.TB")
+		return ""
+	}
+	name = unsafePathRx.ReplaceAllString(name, "_")
+	return filepath.Join("testdata", filepath.FromSlash(name)+".golden")
+}
+
+// ExpGolden tests that all logged entries, after applying scrubbers, are
+// equal to the contents of the golden file at path. When no scrubbers are
+// given DefaultScrubbers are used. When UpdateFlag or UpdateEnv is set
+// the golden file is written instead. It calls Fatal on I/O errors or
+// when any of the log entries cannot be decoded.
+func (tst *Tester) ExpGolden(path string, scrubbers ...Scrubber) {
+	tst.t.Helper()
+	if len(scrubbers) == 0 {
+		scrubbers = DefaultScrubbers()
+	}
+
+	have, err := tst.scrubbed(scrubbers)
+	if err != nil {
+		tst.t.Fatal(err)
+		return
+	}
+
+	if updateGolden() {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			tst.t.Fatal(err)
+			return
+		}
+		if err := ioutil.WriteFile(path, []byte(have), 0644); err != nil {
+			tst.t.Fatal(err)
+		}
+		return
+	}
+
+	exp, err := ioutil.ReadFile(path)
+	if err != nil {
+		tst.t.Fatal(err)
+		return
+	}
+
+	if diff := linesDiff(string(exp), have); len(diff) > 0 {
+		tst.t.Errorf(
+			"expected log entries to match golden file '%s':\n%s",
+			path,
+			strings.Join(diff, "\n"),
+		)
+	}
+}
+
+// updateGolden returns true when golden files should be rewritten.
+// See UpdateFlag and UpdateEnv.
+func updateGolden() bool {
+	if f := flag.Lookup(UpdateFlag); f != nil {
+		if g, ok := f.Value.(flag.Getter); ok {
+			if set, ok := g.Get().(bool); ok && set {
+				return true
+			}
+		}
+	}
+	set, _ := strconv.ParseBool(os.Getenv(UpdateEnv))
+	return set
+}
+
+// scrubbed returns all logged entries, one per line, with fields
+// normalized by scrubbers.
+func (tst *Tester) scrubbed(scrubbers []Scrubber) (string, error) {
+	tst.t.Helper()
+	buf := &bytes.Buffer{}
+	for _, ent := range tst.Entries().Get() {
+		fields, err := entryFields([]byte(ent.raw))
+		if err != nil {
+			return "", err
+		}
+
+		buf.WriteByte('{')
+		for i, fld := range fields {
+			val := string(fld.val)
+			for _, scr := range scrubbers {
+				val = scr(fld.key, val)
+			}
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(jsonString(fld.key))
+			buf.WriteByte(':')
+			buf.WriteString(val)
+		}
+		buf.WriteString("}\n")
+	}
+	return buf.String(), nil
+}
+
+// linesDiff returns differences between exp and have compared line by line.
+func linesDiff(exp, have string) []string {
+	expL := strings.Split(strings.TrimSuffix(exp, "\n"), "\n")
+	haveL := strings.Split(strings.TrimSuffix(have, "\n"), "\n")
+
+	diff := make([]string, 0)
+	for i := 0; i < len(expL) || i < len(haveL); i++ {
+		var expS, haveS string
+		if i < len(expL) {
+			expS = expL[i]
+		}
+		if i < len(haveL) {
+			haveS = haveL[i]
+		}
+		if expS != haveS {
+			diff = append(diff, fmt.Sprintf("  line %d expected: %s", i+1, expS))
+			diff = append(diff, fmt.Sprintf("  line %d got:      %s", i+1, haveS))
+		}
+	}
+	return diff
+}
+
+// jsonString returns s encoded as JSON string.
+func jsonString(s string) string {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
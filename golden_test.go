@@ -0,0 +1,190 @@
+package zltest
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/rzajac/zltest/internal"
+)
+
+func Test_Tester_ExpGolden(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst).With().Timestamp().Logger()
+
+	// --- When ---
+	log.Info().Str("id", "123e4567-e89b-12d3-a456-426614174000").Msg("login")
+	log.Error().Dur("elapsed", 12*time.Millisecond).Msg("failed")
+
+	// --- Then ---
+	tst.ExpGolden(
+		"testdata/login.golden",
+		append(DefaultScrubbers(), ScrubDurations("elapsed"))...,
+	)
+}
+
+func Test_Tester_ExpGolden_mismatch(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected log entries to match golden file '%s':\n%s",
+		"testdata/login.golden",
+		mock.AnythingOfType("string"),
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Info().Msg("logout")
+
+	// --- When ---
+	tst.ExpGolden("testdata/login.golden")
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Tester_ExpGolden_missingFile(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Fatal", mock.Anything)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Info().Msg("login")
+
+	// --- When ---
+	tst.ExpGolden("testdata/missing.golden")
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+// Register the update flag the way users of the package do.
+var _ = flag.Bool(UpdateFlag, false, "update golden files")
+
+func Test_Tester_ExpGolden_updateFlag(t *testing.T) {
+	// --- Given ---
+	require.NoError(t, flag.Set(UpdateFlag, "true"))
+	defer func() { _ = flag.Set(UpdateFlag, "false") }()
+
+	pth := filepath.Join(t.TempDir(), "sub", "test.golden")
+	tst := New(t)
+	log := zerolog.New(tst).With().Timestamp().Logger()
+	log.Info().Str("user", "<b>").Msg("login")
+
+	// --- When ---
+	tst.ExpGolden(pth)
+
+	// --- Then ---
+	got, err := ioutil.ReadFile(pth)
+	require.NoError(t, err)
+	exp := `{"level":"info","user":"<b>","time":"<timestamp>","message":"login"}` + "\n"
+	assert.Exactly(t, exp, string(got))
+}
+
+func Test_Tester_ExpGolden_updateEnv(t *testing.T) {
+	// --- Given ---
+	require.NoError(t, os.Setenv(UpdateEnv, "1"))
+	defer func() { _ = os.Unsetenv(UpdateEnv) }()
+
+	pth := filepath.Join(t.TempDir(), "test.golden")
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Info().Msg("login")
+
+	// --- When ---
+	tst.ExpGolden(pth)
+
+	// --- Then ---
+	got, err := ioutil.ReadFile(pth)
+	require.NoError(t, err)
+	assert.Exactly(t, `{"level":"info","message":"login"}`+"\n", string(got))
+}
+
+func Test_ScrubRegexp(t *testing.T) {
+	// --- Given ---
+	scr := ScrubRegexp(regexp.MustCompile(`\d+`), "N")
+
+	// --- When ---
+	got := scr("key", `"abc 123 def 4"`)
+
+	// --- Then ---
+	assert.Exactly(t, `"abc N def N"`, got)
+}
+
+func Test_ScrubDurations(t *testing.T) {
+	// --- Given ---
+	scr := ScrubDurations("dur", "str")
+
+	// --- Then ---
+	assert.Exactly(t, `"<dur>"`, scr("dur", `12.5`))
+	assert.Exactly(t, `"<dur>"`, scr("str", `"1m30s"`))
+	assert.Exactly(t, `"abc"`, scr("str", `"abc"`))
+	assert.Exactly(t, `12`, scr("other", `12`))
+}
+
+func Test_ScrubCallerLine(t *testing.T) {
+	// --- Given ---
+	scr := ScrubCallerLine()
+
+	// --- Then ---
+	assert.Exactly(t, `"/a/b.go:<line>"`, scr(zerolog.CallerFieldName, `"/a/b.go:42"`))
+	assert.Exactly(t, `"/a/b.go:42"`, scr("other", `"/a/b.go:42"`))
+}
+
+func Test_linesDiff(t *testing.T) {
+	// --- When ---
+	got := linesDiff("a\nb\n", "a\nc\nd\n")
+
+	// --- Then ---
+	exp := []string{
+		"  line 2 expected: b",
+		"  line 2 got:      c",
+		"  line 3 expected: ",
+		"  line 3 got:      d",
+	}
+	assert.Exactly(t, exp, got)
+}
+
+func Test_Tester_GoldenPath(t *testing.T) {
+	t.Run("sub test #1", func(t *testing.T) {
+		// --- Given ---
+		tst := New(t)
+
+		// --- When ---
+		got := tst.GoldenPath()
+
+		// --- Then ---
+		exp := filepath.Join("testdata", "Test_Tester_GoldenPath", "sub_test__1.golden")
+		assert.Exactly(t, exp, got)
+	})
+}
+
+func Test_Tester_GoldenPath_notTB(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Fatal", "golden file path requires T implementing zltest.TB")
+
+	tst := New(mck)
+
+	// --- When ---
+	got := tst.GoldenPath()
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+	assert.Exactly(t, "", got)
+}
@@ -0,0 +1,162 @@
+// Package gomega provides Gomega matchers for zerolog log entries captured
+// with zltest.Tester.
+//
+// All matchers accept *zltest.Tester, zltest.Entries or *zltest.Entry as
+// actual value and succeed when at least one of the entries matches. Because
+// *zltest.Tester is decoded on every match they can be used with Eventually
+// to test asynchronous code:
+//
+//	Eventually(tst).Should(HaveLoggedMsg("done"))
+package gomega
+
+import (
+	"fmt"
+	"strings"
+
+	g "github.com/onsi/gomega"
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/types"
+	"github.com/rs/zerolog"
+
+	"github.com/rzajac/zltest"
+)
+
+// HaveLogged succeeds if at least one log entry matches all the matchers.
+// The matchers receive *zltest.Entry as actual value.
+func HaveLogged(matchers ...types.GomegaMatcher) types.GomegaMatcher {
+	return &entryMatcher{
+		desc: "matching all of\n" + format.Object(matchers, 1),
+		match: func(ent *zltest.Entry) bool {
+			for _, m := range matchers {
+				if ok, err := m.Match(ent); err != nil || !ok {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
+
+// HaveLoggedMsg succeeds if at least one log entry message field
+// (zerolog.MessageFieldName) is equal to msg.
+func HaveLoggedMsg(msg string) types.GomegaMatcher {
+	return HaveField(zerolog.MessageFieldName, msg)
+}
+
+// HaveField succeeds if at least one log entry has a field key with value
+// matching exp. When exp is not a matcher it's compared using
+// BeEquivalentTo, so for example int 1 matches JSON number 1, and nil
+// matches JSON null using BeNil. The field values are decoded from JSON,
+// objects are map[string]interface{} and numbers are float64.
+func HaveField(key string, exp interface{}) types.GomegaMatcher {
+	m, ok := exp.(types.GomegaMatcher)
+	switch {
+	case ok:
+	case exp == nil:
+		m = g.BeNil()
+	default:
+		m = g.BeEquivalentTo(exp)
+	}
+	return &entryMatcher{
+		desc: fmt.Sprintf("with field '%s' matching\n%s", key, format.Object(m, 1)),
+		match: func(ent *zltest.Entry) bool {
+			var val interface{}
+			if ent.DecodeField(key, &val) != zltest.KeyFound {
+				return false
+			}
+			ok, err := m.Match(val)
+			return err == nil && ok
+		},
+	}
+}
+
+// HaveLevel succeeds if at least one log entry has level field
+// (zerolog.LevelFieldName) equal to level.
+func HaveLevel(level zerolog.Level) types.GomegaMatcher {
+	return &entryMatcher{
+		desc: fmt.Sprintf("with level '%s'", level),
+		match: func(ent *zltest.Entry) bool {
+			lvl, status := ent.Level()
+			return status == zltest.KeyFound && lvl == level
+		},
+	}
+}
+
+// entryMatcher implements types.GomegaMatcher succeeding if at least one
+// log entry matches.
+type entryMatcher struct {
+	desc  string                   // Description used in failure messages.
+	match func(*zltest.Entry) bool // Returns true if entry matches.
+}
+
+// Match implements types.GomegaMatcher interface.
+func (m *entryMatcher) Match(actual interface{}) (bool, error) {
+	ets, err := entries(actual)
+	if err != nil {
+		return false, err
+	}
+	for _, ent := range ets {
+		if m.match(ent) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// FailureMessage implements types.GomegaMatcher interface.
+func (m *entryMatcher) FailureMessage(actual interface{}) string {
+	return fmt.Sprintf(
+		"Expected log entries\n%s\nto contain entry %s",
+		formatEntries(actual),
+		m.desc,
+	)
+}
+
+// NegatedFailureMessage implements types.GomegaMatcher interface.
+func (m *entryMatcher) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf(
+		"Expected log entries\n%s\nnot to contain entry %s",
+		formatEntries(actual),
+		m.desc,
+	)
+}
+
+// entries returns log entries from actual value.
+func entries(actual interface{}) ([]*zltest.Entry, error) {
+	switch act := actual.(type) {
+	case *zltest.Tester:
+		return act.Entries().Get(), nil
+	case zltest.Entries:
+		return act.Get(), nil
+	case *zltest.Entry:
+		return []*zltest.Entry{act}, nil
+	default:
+		return nil, fmt.Errorf(
+			"expected *zltest.Tester, zltest.Entries or *zltest.Entry. Got:\n%s",
+			format.Object(actual, 1),
+		)
+	}
+}
+
+// formatEntries formats log entries from actual value for failure messages
+// using the Renderer configured with zltest.WithRenderer option.
+func formatEntries(actual interface{}) string {
+	var lines []string
+	switch act := actual.(type) {
+	case *zltest.Tester:
+		lines = act.Entries().Render()
+	case zltest.Entries:
+		lines = act.Render()
+	case *zltest.Entry:
+		lines = act.Render()
+	default:
+		return format.Object(actual, 1)
+	}
+	if len(lines) == 0 {
+		return "    <no entries>"
+	}
+	for i, line := range lines {
+		lines[i] = format.Indent + strings.Replace(line, "\n", "\n"+format.Indent, -1)
+	}
+	return strings.Join(lines, "\n")
+}
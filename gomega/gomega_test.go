@@ -0,0 +1,169 @@
+package gomega
+
+import (
+	"testing"
+	"time"
+
+	g "github.com/onsi/gomega"
+	"github.com/rs/zerolog"
+
+	"github.com/rzajac/zltest"
+)
+
+func Test_HaveLogged(t *testing.T) {
+	// --- Given ---
+	gt := g.NewWithT(t)
+	tst := zltest.New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Info().Str("user", "bob").Int("age", 42).Msg("login")
+	log.Error().Str("user", "joe").Msg("logout")
+
+	// --- Then ---
+	gt.Expect(tst).To(HaveLogged(HaveField("user", "bob"), HaveLevel(zerolog.InfoLevel)))
+	gt.Expect(tst).To(HaveLogged(HaveField("age", 42)))
+	gt.Expect(tst).NotTo(HaveLogged(HaveField("user", "bob"), HaveLevel(zerolog.ErrorLevel)))
+	gt.Expect(tst.Entries()).To(HaveLogged(HaveLoggedMsg("logout")))
+	gt.Expect(tst.LastEntry()).To(HaveLogged(HaveField("user", g.HavePrefix("jo"))))
+	gt.Expect(tst.LastEntry()).NotTo(HaveLogged(HaveField("user", "bob")))
+}
+
+func Test_HaveLoggedMsg(t *testing.T) {
+	// --- Given ---
+	gt := g.NewWithT(t)
+	tst := zltest.New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Info().Msg("message")
+
+	// --- Then ---
+	gt.Expect(tst).To(HaveLoggedMsg("message"))
+	gt.Expect(tst).NotTo(HaveLoggedMsg("other"))
+}
+
+func Test_HaveField(t *testing.T) {
+	// --- Given ---
+	gt := g.NewWithT(t)
+	tst := zltest.New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Info().
+		Str("str", "abc").
+		Float64("num", 1.5).
+		Bool("bool", true).
+		Dict("map", zerolog.Dict().Str("key", "val")).
+		Interface("null", nil).
+		Send()
+
+	// --- Then ---
+	ent := tst.LastEntry()
+	gt.Expect(ent).To(HaveField("str", "abc"))
+	gt.Expect(ent).To(HaveField("num", g.BeNumerically(">", 1)))
+	gt.Expect(ent).To(HaveField("bool", true))
+	gt.Expect(ent).To(HaveField("map", g.HaveKeyWithValue("key", "val")))
+	gt.Expect(ent).NotTo(HaveField("str", "xyz"))
+	gt.Expect(ent).To(HaveField("null", nil))
+	gt.Expect(ent).NotTo(HaveField("str", nil))
+	gt.Expect(ent).NotTo(HaveField("missing", g.BeNil()))
+	gt.Expect(ent).NotTo(HaveField("missing", nil))
+}
+
+func Test_HaveLevel(t *testing.T) {
+	// --- Given ---
+	gt := g.NewWithT(t)
+	tst := zltest.New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Warn().Send()
+
+	// --- Then ---
+	gt.Expect(tst).To(HaveLevel(zerolog.WarnLevel))
+	gt.Expect(tst).NotTo(HaveLevel(zerolog.ErrorLevel))
+}
+
+func Test_HaveLogged_Eventually(t *testing.T) {
+	// --- Given ---
+	gt := g.NewWithT(t)
+	tst := zltest.New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		log.Info().Msg("done")
+	}()
+
+	// --- Then ---
+	gt.Eventually(tst).Should(HaveLoggedMsg("done"))
+}
+
+func Test_HaveLogged_NoEntries(t *testing.T) {
+	// --- Given ---
+	gt := g.NewWithT(t)
+	tst := zltest.New(t)
+	m := HaveLoggedMsg("message")
+
+	// --- When ---
+	ok, err := m.Match(tst)
+
+	// --- Then ---
+	gt.Expect(err).NotTo(g.HaveOccurred())
+	gt.Expect(ok).To(g.BeFalse())
+	gt.Expect(m.FailureMessage(tst)).To(g.ContainSubstring("<no entries>"))
+}
+
+func Test_HaveLogged_FailureMessage(t *testing.T) {
+	// --- Given ---
+	gt := g.NewWithT(t)
+	tst := zltest.New(t)
+	log := zerolog.New(tst)
+	log.Info().Msg("message")
+	m := HaveLevel(zerolog.ErrorLevel)
+
+	// --- When ---
+	msg := m.FailureMessage(tst)
+	neg := m.NegatedFailureMessage(tst)
+
+	// --- Then ---
+	exp := "Expected log entries\n" +
+		"    {\"level\":\"info\",\"message\":\"message\"}\n" +
+		"to contain entry with level 'error'"
+	gt.Expect(msg).To(g.Equal(exp))
+	gt.Expect(neg).To(g.ContainSubstring("not to contain entry with level 'error'"))
+}
+
+func Test_HaveLogged_FailureMessage_renderer(t *testing.T) {
+	// --- Given ---
+	gt := g.NewWithT(t)
+	tst := zltest.New(t, zltest.WithRenderer(zltest.TableRenderer("level", "message")))
+	log := zerolog.New(tst)
+	log.Info().Msg("message")
+	m := HaveLevel(zerolog.ErrorLevel)
+
+	// --- When ---
+	msg := m.FailureMessage(tst)
+
+	// --- Then ---
+	exp := "Expected log entries\n" +
+		"    level | message\n" +
+		"    info  | message\n" +
+		"to contain entry with level 'error'"
+	gt.Expect(msg).To(g.Equal(exp))
+}
+
+func Test_HaveLogged_BadActual(t *testing.T) {
+	// --- Given ---
+	gt := g.NewWithT(t)
+	m := HaveLoggedMsg("message")
+
+	// --- When ---
+	ok, err := m.Match("not entries")
+
+	// --- Then ---
+	gt.Expect(err).To(g.HaveOccurred())
+	gt.Expect(ok).To(g.BeFalse())
+}
@@ -0,0 +1,405 @@
+package zltest
+
+import (
+	"net"
+	"runtime"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rs/zerolog"
+)
+
+// Predicates in this file are counterparts of Exp* assertions. They return
+// the result of the check instead of reporting failures to T, so they can
+// be used in custom helpers, matchers or retry loops.
+
+// has returns true if the assertion f made on the entry doesn't fail.
+func (ent *Entry) has(f func(*Entry)) bool {
+	st := &silentT{}
+	cpy := *ent
+	cpy.t = st
+	f(&cpy)
+	return !st.failed
+}
+
+// HasDuplicateKeys returns true if log entry has a field key written more
+// than once. See ExpNoDuplicateKeys.
+func (ent *Entry) HasDuplicateKeys() bool {
+	return !ent.has(func(e *Entry) { e.ExpNoDuplicateKeys() })
+}
+
+// HasKey returns true if log entry has a field key. See ExpKey.
+func (ent *Entry) HasKey(key string) bool {
+	return ent.has(func(e *Entry) { e.ExpKey(key) })
+}
+
+// HasNumKeys returns true if log entry has n keys. See ExpNumKeys.
+func (ent *Entry) HasNumKeys(n int) bool {
+	return ent.has(func(e *Entry) { e.ExpNumKeys(n) })
+}
+
+// HasKeys returns true if log entry has exactly the set of field keys exp.
+// See ExpKeys.
+func (ent *Entry) HasKeys(exp ...string) bool {
+	return ent.has(func(e *Entry) { e.ExpKeys(exp...) })
+}
+
+// HasKeysSubset returns true if log entry has all the field keys exp.
+// See ExpKeysSubset.
+func (ent *Entry) HasKeysSubset(exp ...string) bool {
+	return ent.has(func(e *Entry) { e.ExpKeysSubset(exp...) })
+}
+
+// HasKeysOrdered returns true if log entry field keys were written exactly
+// in the exp order. See ExpKeysOrdered.
+func (ent *Entry) HasKeysOrdered(exp ...string) bool {
+	return ent.has(func(e *Entry) { e.ExpKeysOrdered(exp...) })
+}
+
+// HasStr returns true if log entry has a field key, its value is a string,
+// and it's equal to exp. See ExpStr.
+func (ent *Entry) HasStr(key string, exp string) bool {
+	return ent.has(func(e *Entry) { e.ExpStr(key, exp) })
+}
+
+// HasStrContains returns true if log entry has a field key, its value is
+// a string, and it contains exp. See ExpStrContains.
+func (ent *Entry) HasStrContains(key string, exp string) bool {
+	return ent.has(func(e *Entry) { e.ExpStrContains(key, exp) })
+}
+
+// HasBool returns true if log entry has a field key, its value is boolean
+// and equal to exp. See ExpBool.
+func (ent *Entry) HasBool(key string, exp bool) bool {
+	return ent.has(func(e *Entry) { e.ExpBool(key, exp) })
+}
+
+// HasTime returns true if log entry has a field key, its value is a string
+// representing time in zerolog.TimeFieldFormat and it's equal to exp.
+// See ExpTime.
+func (ent *Entry) HasTime(key string, exp time.Time) bool {
+	return ent.has(func(e *Entry) { e.ExpTime(key, exp) })
+}
+
+// HasTimeWithin returns true if log entry has a field key, its value is
+// a string representing time in zerolog.TimeFieldFormat and it's within
+// +/- diff from exp. See ExpTimeWithin.
+func (ent *Entry) HasTimeWithin(key string, exp time.Time, diff time.Duration) bool {
+	return ent.has(func(e *Entry) { e.ExpTimeWithin(key, exp, diff) })
+}
+
+// HasDur returns true if log entry has a field key and its value is equal
+// to exp time.Duration. See ExpDur.
+func (ent *Entry) HasDur(key string, exp time.Duration) bool {
+	return ent.has(func(e *Entry) { e.ExpDur(key, exp) })
+}
+
+// IsLoggedWithin returns true if log entry was logged at exp time +/- diff.
+// See ExpLoggedWithin.
+func (ent *Entry) IsLoggedWithin(exp time.Time, diff time.Duration) bool {
+	return ent.has(func(e *Entry) { e.ExpLoggedWithin(exp, diff) })
+}
+
+// HasMsg returns true if log entry message field (zerolog.MessageFieldName)
+// is equal to exp. See ExpMsg.
+func (ent *Entry) HasMsg(exp string) bool {
+	return ent.has(func(e *Entry) { e.ExpMsg(exp) })
+}
+
+// HasError returns true if log entry error field (zerolog.ErrorFieldName)
+// is equal to exp. See ExpError.
+func (ent *Entry) HasError(exp string) bool {
+	return ent.has(func(e *Entry) { e.ExpError(exp) })
+}
+
+// HasErr returns true if log entry error field (zerolog.ErrorFieldName)
+// is equal to exp error message. See ExpErr.
+func (ent *Entry) HasErr(exp error) bool {
+	return ent.has(func(e *Entry) { e.ExpErr(exp) })
+}
+
+// IsLevel returns true if log entry level field (zerolog.LevelFieldName)
+// is equal to exp. See ExpLevel.
+func (ent *Entry) IsLevel(exp zerolog.Level) bool {
+	return ent.has(func(e *Entry) { e.ExpLevel(exp) })
+}
+
+// HasNum returns true if log entry has a field key and its numerical value
+// is equal to exp. See ExpNum.
+func (ent *Entry) HasNum(key string, exp float64) bool {
+	return ent.has(func(e *Entry) { e.ExpNum(key, exp) })
+}
+
+// HasFields returns true if log entry has all the fields from exp and their
+// values are equal. See ExpFields.
+func (ent *Entry) HasFields(exp map[string]interface{}) bool {
+	return ent.has(func(e *Entry) { e.ExpFields(exp) })
+}
+
+// IsEqual returns true if log entry is equal to exp JSON object ignoring
+// fields with keys listed in ignore. See ExpEqual.
+func (ent *Entry) IsEqual(exp string, ignore ...string) bool {
+	return ent.has(func(e *Entry) { e.ExpEqual(exp, ignore...) })
+}
+
+// IsCmp returns true if log entry is equal to exp using cmp.Diff with opts.
+// See ExpCmp.
+func (ent *Entry) IsCmp(exp interface{}, opts ...cmp.Option) bool {
+	return ent.has(func(e *Entry) { e.ExpCmp(exp, opts...) })
+}
+
+// HasStrs returns true if log entry has a field key, its value is an array
+// of strings, and it's equal to exp. See ExpStrs.
+func (ent *Entry) HasStrs(key string, exp []string) bool {
+	return ent.has(func(e *Entry) { e.ExpStrs(key, exp) })
+}
+
+// HasArrayLen returns true if log entry has a field key, its value is
+// an array, and it has exp elements. See ExpArrayLen.
+func (ent *Entry) HasArrayLen(key string, exp int) bool {
+	return ent.has(func(e *Entry) { e.ExpArrayLen(key, exp) })
+}
+
+// HasArrayContains returns true if log entry has a field key, its value is
+// an array, and at least one of its elements is equal to exp.
+// See ExpArrayContains.
+func (ent *Entry) HasArrayContains(key string, exp interface{}) bool {
+	return ent.has(func(e *Entry) { e.ExpArrayContains(key, exp) })
+}
+
+// HasIP returns true if log entry has a field key, its value is a string
+// representing IP address and it's equal to exp. See ExpIP.
+func (ent *Entry) HasIP(key string, exp net.IP) bool {
+	return ent.has(func(e *Entry) { e.ExpIP(key, exp) })
+}
+
+// HasIPNet returns true if log entry has a field key, its value is a string
+// representing IP network and it's equal to exp. See ExpIPNet.
+func (ent *Entry) HasIPNet(key string, exp net.IPNet) bool {
+	return ent.has(func(e *Entry) { e.ExpIPNet(key, exp) })
+}
+
+// HasMAC returns true if log entry has a field key, its value is a string
+// representing MAC address and it's equal to exp. See ExpMAC.
+func (ent *Entry) HasMAC(key string, exp net.HardwareAddr) bool {
+	return ent.has(func(e *Entry) { e.ExpMAC(key, exp) })
+}
+
+// HasHexBytes returns true if log entry has a field key, its value is a hex
+// encoded string and decoded bytes are equal to exp. See ExpHexBytes.
+func (ent *Entry) HasHexBytes(key string, exp []byte) bool {
+	return ent.has(func(e *Entry) { e.ExpHexBytes(key, exp) })
+}
+
+// HasBytes returns true if log entry has a field key, its value is a string
+// and it's equal to exp. See ExpBytes.
+func (ent *Entry) HasBytes(key string, exp []byte) bool {
+	return ent.has(func(e *Entry) { e.ExpBytes(key, exp) })
+}
+
+// HasCallerFile returns true if log entry caller field has file path ending
+// with suffix. See ExpCallerFile.
+func (ent *Entry) HasCallerFile(suffix string) bool {
+	return ent.has(func(e *Entry) { e.ExpCallerFile(suffix) })
+}
+
+// HasCallerFunc returns true if log entry caller field has function name
+// ending with suffix. See ExpCallerFunc.
+func (ent *Entry) HasCallerFunc(suffix string) bool {
+	return ent.has(func(e *Entry) { e.ExpCallerFunc(suffix) })
+}
+
+// HasCallerThisFile returns true if log entry caller field points to the
+// file HasCallerThisFile is called from. See ExpCallerThisFile.
+func (ent *Entry) HasCallerThisFile() bool {
+	_, file, line, _ := runtime.Caller(1)
+	return ent.has(func(e *Entry) { e.expCallerIn(callerFile(file, line)) })
+}
+
+// HasStack returns true if log entry has a non-empty stack trace field.
+// See ExpStack.
+func (ent *Entry) HasStack() bool {
+	return ent.has(func(e *Entry) { e.ExpStack() })
+}
+
+// HasStackContainsFunc returns true if log entry has a stack trace field
+// with at least one frame whose function name ends with suffix.
+// See ExpStackContainsFunc.
+func (ent *Entry) HasStackContainsFunc(suffix string) bool {
+	return ent.has(func(e *Entry) { e.ExpStackContainsFunc(suffix) })
+}
+
+// has returns true if the assertion f made on at least one of the entries
+// doesn't fail.
+func (ets Entries) has(f func(*Entry)) bool {
+	for _, ent := range ets.e {
+		if ent.has(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// Find returns first entry matching m or nil if there is no such entry.
+func (ets Entries) Find(m Matcher) *Entry {
+	for _, ent := range ets.e {
+		if m(ent) {
+			return ent
+		}
+	}
+	return nil
+}
+
+// FindAll returns all entries matching m.
+func (ets Entries) FindAll(m Matcher) Entries {
+	e := make([]*Entry, 0)
+	for _, ent := range ets.e {
+		if m(ent) {
+			e = append(e, ent)
+		}
+	}
+	return Entries{e: e, r: ets.r, t: ets.t}
+}
+
+// HasLen returns true if there is n entries. See ExpLen.
+func (ets Entries) HasLen(n int) bool {
+	return len(ets.e) == n
+}
+
+// HasLevelAbove returns true if at least one log entry has log level above
+// level. See ExpNoLevelAbove.
+func (ets Entries) HasLevelAbove(level zerolog.Level) bool {
+	return len(ets.FilterRange(level+1, zerolog.PanicLevel).e) > 0
+}
+
+// HasStr returns true if at least one log entry has a field key, its value
+// is a string, and it's equal to exp. See ExpStr.
+func (ets Entries) HasStr(key string, exp string) bool {
+	return ets.has(func(e *Entry) { e.ExpStr(key, exp) })
+}
+
+// HasStrContains returns true if at least one log entry has a field key,
+// its value is a string, and it contains exp. See ExpStrContains.
+func (ets Entries) HasStrContains(key string, exp string) bool {
+	return ets.has(func(e *Entry) { e.ExpStrContains(key, exp) })
+}
+
+// HasTime returns true if at least one log entry has a field key, its value
+// is a string representing time in zerolog.TimeFieldFormat and it's equal
+// to exp. See ExpTime.
+func (ets Entries) HasTime(key string, exp time.Time) bool {
+	return ets.has(func(e *Entry) { e.ExpTime(key, exp) })
+}
+
+// HasDur returns true if at least one log entry has a field key and its
+// value is equal to exp time.Duration. See ExpDur.
+func (ets Entries) HasDur(key string, exp time.Duration) bool {
+	return ets.has(func(e *Entry) { e.ExpDur(key, exp) })
+}
+
+// HasBool returns true if at least one log entry has a field key, its value
+// is boolean and equal to exp. See ExpBool.
+func (ets Entries) HasBool(key string, exp bool) bool {
+	return ets.has(func(e *Entry) { e.ExpBool(key, exp) })
+}
+
+// HasMsg returns true if at least one log entry message field
+// (zerolog.MessageFieldName) is equal to exp. See ExpMsg.
+func (ets Entries) HasMsg(exp string) bool {
+	return ets.has(func(e *Entry) { e.ExpMsg(exp) })
+}
+
+// HasError returns true if at least one log entry error field
+// (zerolog.ErrorFieldName) is equal to exp. See ExpError.
+func (ets Entries) HasError(exp string) bool {
+	return ets.has(func(e *Entry) { e.ExpError(exp) })
+}
+
+// HasErr returns true if at least one log entry error field
+// (zerolog.ErrorFieldName) is equal to exp error message. See ExpErr.
+func (ets Entries) HasErr(exp error) bool {
+	return ets.has(func(e *Entry) { e.ExpErr(exp) })
+}
+
+// HasNum returns true if at least one log entry has a field key and its
+// numerical value is equal to exp. See ExpNum.
+func (ets Entries) HasNum(key string, exp float64) bool {
+	return ets.has(func(e *Entry) { e.ExpNum(key, exp) })
+}
+
+// HasStrs returns true if at least one log entry has a field key, its value
+// is an array of strings, and it's equal to exp. See ExpStrs.
+func (ets Entries) HasStrs(key string, exp []string) bool {
+	return ets.has(func(e *Entry) { e.ExpStrs(key, exp) })
+}
+
+// HasArrayLen returns true if at least one log entry has a field key, its
+// value is an array, and it has exp elements. See ExpArrayLen.
+func (ets Entries) HasArrayLen(key string, exp int) bool {
+	return ets.has(func(e *Entry) { e.ExpArrayLen(key, exp) })
+}
+
+// HasArrayContains returns true if at least one log entry has a field key,
+// its value is an array, and at least one of its elements is equal to exp.
+// See ExpArrayContains.
+func (ets Entries) HasArrayContains(key string, exp interface{}) bool {
+	return ets.has(func(e *Entry) { e.ExpArrayContains(key, exp) })
+}
+
+// HasDuplicateKeys returns true if any of the logged entries has a field
+// key written more than once. See ExpNoDuplicateKeys.
+func (tst *Tester) HasDuplicateKeys() bool {
+	for _, ent := range tst.Entries().Get() {
+		if ent.HasDuplicateKeys() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasLevelAbove returns true if at least one logged entry has log level
+// above level. See ExpNoLevelAbove.
+func (tst *Tester) HasLevelAbove(level zerolog.Level) bool {
+	return tst.Entries().HasLevelAbove(level)
+}
+
+// HasErrors returns true if at least one entry at zerolog.ErrorLevel or
+// above, not matching any of the allow matchers, was logged.
+// See ExpNoErrors.
+func (tst *Tester) HasErrors(allow ...Matcher) bool {
+	for _, ent := range tst.FilterMin(zerolog.ErrorLevel).Get() {
+		if !matchAny(ent, allow) {
+			return true
+		}
+	}
+	return false
+}
+
+// silentT implements T interface recording failures without reporting them.
+type silentT struct {
+	failed bool // Set when any of the failure methods was called.
+}
+
+// Error implements T interface.
+func (st *silentT) Error(args ...interface{}) { st.failed = true }
+
+// Errorf implements T interface.
+func (st *silentT) Errorf(format string, args ...interface{}) { st.failed = true }
+
+// Fatal implements T interface.
+func (st *silentT) Fatal(args ...interface{}) { st.failed = true }
+
+// Fatalf implements T interface.
+func (st *silentT) Fatalf(format string, args ...interface{}) { st.failed = true }
+
+// Helper implements T interface.
+func (st *silentT) Helper() {}
+
+// Log implements T interface.
+func (st *silentT) Log(args ...interface{}) {}
+
+// Logf implements T interface.
+func (st *silentT) Logf(format string, args ...interface{}) {}
+
+// Cleanup implements T interface.
+func (st *silentT) Cleanup(func()) {}
@@ -0,0 +1,121 @@
+package zltest
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/rzajac/zltest/internal"
+)
+
+func Test_Entry_Has(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+
+	tst := New(mck)
+	log := zerolog.New(tst).With().Caller().Logger()
+	tim := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	log.Error().
+		Str("str", "val").
+		Bool("bool", true).
+		Int("num", 42).
+		Time("time", tim).
+		Dur("dur", time.Second).
+		Strs("strs", []string{"a", "b"}).
+		IPAddr("ip", net.IPv4(10, 0, 0, 1)).
+		Err(errors.New("err")).
+		Msg("msg")
+	ent := tst.LastEntry()
+
+	// --- Then ---
+	assert.True(t, ent.HasKey("str"))
+	assert.False(t, ent.HasKey("missing"))
+	assert.True(t, ent.HasStr("str", "val"))
+	assert.False(t, ent.HasStr("str", "other"))
+	assert.False(t, ent.HasStr("num", "42"))
+	assert.True(t, ent.HasStrContains("str", "va"))
+	assert.True(t, ent.HasBool("bool", true))
+	assert.False(t, ent.HasBool("bool", false))
+	assert.True(t, ent.HasNum("num", 42))
+	assert.False(t, ent.HasNum("num", 41))
+	assert.True(t, ent.HasTime("time", tim))
+	assert.True(t, ent.HasTimeWithin("time", tim.Add(time.Second), 2*time.Second))
+	assert.False(t, ent.HasTimeWithin("time", tim.Add(time.Hour), time.Second))
+	assert.True(t, ent.HasDur("dur", time.Second))
+	assert.True(t, ent.HasStrs("strs", []string{"a", "b"}))
+	assert.True(t, ent.HasArrayLen("strs", 2))
+	assert.True(t, ent.HasArrayContains("strs", "b"))
+	assert.False(t, ent.HasArrayContains("strs", "c"))
+	assert.True(t, ent.HasIP("ip", net.IPv4(10, 0, 0, 1)))
+	assert.True(t, ent.HasMsg("msg"))
+	assert.True(t, ent.HasError("err"))
+	assert.True(t, ent.HasErr(errors.New("err")))
+	assert.True(t, ent.IsLevel(zerolog.ErrorLevel))
+	assert.False(t, ent.IsLevel(zerolog.InfoLevel))
+	assert.True(t, ent.HasKeysSubset("str", "num"))
+	assert.False(t, ent.HasKeys("str", "num"))
+	assert.True(t, ent.HasFields(map[string]interface{}{"str": "val", "num": 42}))
+	assert.False(t, ent.IsEqual("{ bad json }"))
+	assert.False(t, ent.IsCmp(map[string]interface{}{"str": "other"}))
+	assert.True(t, ent.HasCallerThisFile())
+	assert.True(t, ent.HasCallerFile("has_test.go"))
+	assert.False(t, ent.HasStack())
+	assert.False(t, ent.HasDuplicateKeys())
+	mck.AssertExpectations(t)
+}
+
+func Test_Entries_Has(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Info().Str("key0", "val0").Int("num", 1).Msg("msg0")
+	log.Error().Str("key1", "val1").Err(errors.New("err")).Msg("msg1")
+	ets := tst.Entries()
+
+	// --- Then ---
+	assert.True(t, ets.HasLen(2))
+	assert.False(t, ets.HasLen(3))
+	assert.True(t, ets.HasMsg("msg0"))
+	assert.True(t, ets.HasMsg("msg1"))
+	assert.False(t, ets.HasMsg("msg2"))
+	assert.True(t, ets.HasStr("key1", "val1"))
+	assert.True(t, ets.HasNum("num", 1))
+	assert.True(t, ets.HasErr(errors.New("err")))
+	assert.True(t, ets.HasLevelAbove(zerolog.WarnLevel))
+	assert.False(t, ets.HasLevelAbove(zerolog.ErrorLevel))
+	assert.True(t, tst.HasErrors())
+	assert.False(t, tst.HasErrors(func(ent *Entry) bool { return ent.HasError("err") }))
+	assert.False(t, tst.HasDuplicateKeys())
+	mck.AssertExpectations(t)
+}
+
+func Test_Entries_Find(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Info().Str("key", "a").Msg("msg0")
+	log.Error().Str("key", "b").Msg("msg1")
+	log.Error().Str("key", "a").Msg("msg2")
+
+	isA := func(ent *Entry) bool { return ent.HasStr("key", "a") }
+	isC := func(ent *Entry) bool { return ent.HasStr("key", "c") }
+
+	// --- When ---
+	first := tst.Entries().Find(isA)
+	all := tst.Entries().FindAll(isA)
+
+	// --- Then ---
+	first.ExpMsg("msg0")
+	all.ExpLen(2)
+	all.ExpMsg("msg2")
+	assert.Nil(t, tst.Entries().Find(isC))
+	tst.Entries().FindAll(isC).ExpLen(0)
+}
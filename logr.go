@@ -0,0 +1,114 @@
+package zltest
+
+import (
+	"math"
+
+	"github.com/go-logr/logr"
+	"github.com/rs/zerolog"
+)
+
+// Field names and separator used by LogrSink. The defaults are the same as
+// in the github.com/go-logr/zerologr package.
+var (
+	// LogrNameFieldName is the field name used for logger name.
+	LogrNameFieldName = "logger"
+
+	// LogrNameSeparator separates logger names added with WithName.
+	LogrNameSeparator = "/"
+
+	// LogrVerbosityFieldName is the field name used for V-level.
+	LogrVerbosityFieldName = "v"
+)
+
+// LogrSink returns logr.LogSink writing log entries to the Tester. The
+// V-levels are mapped to zerolog levels the same way zerologr does it:
+// V(0) is info, V(1) is debug, V(2) is trace and higher V-levels are
+// written as negative numeric levels. All V-levels are enabled.
+func (tst *Tester) LogrSink() logr.LogSink {
+	return &logrSink{log: zerolog.New(tst)}
+}
+
+// Logr returns logr.Logger using LogrSink.
+func (tst *Tester) Logr() logr.Logger {
+	return logr.New(tst.LogrSink())
+}
+
+// logrSink implements logr.LogSink writing to zerolog.Logger.
+type logrSink struct {
+	log  zerolog.Logger // Logger writing to the Tester.
+	name string         // Logger name.
+}
+
+// Init implements logr.LogSink interface.
+func (ls *logrSink) Init(logr.RuntimeInfo) {}
+
+// Enabled implements logr.LogSink interface. All levels are enabled.
+func (ls *logrSink) Enabled(int) bool {
+	return true
+}
+
+// Info implements logr.LogSink interface.
+func (ls *logrSink) Info(level int, msg string, keysAndValues ...interface{}) {
+	// Log with no level, so the event is never filtered out by
+	// zerolog.GlobalLevel, and add the level field by hand.
+	lvl := 1 - level
+	if lvl < math.MinInt8 {
+		lvl = math.MinInt8
+	}
+	evt := ls.log.Log().
+		Str(zerolog.LevelFieldName, zerolog.LevelFieldMarshalFunc(zerolog.Level(lvl))).
+		Int(LogrVerbosityFieldName, level)
+	ls.msg(evt, msg, keysAndValues)
+}
+
+// Error implements logr.LogSink interface.
+func (ls *logrSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	ls.msg(ls.log.Error().Err(err), msg, keysAndValues)
+}
+
+// msg adds logger name and key value pairs to the event and writes it.
+func (ls *logrSink) msg(evt *zerolog.Event, msg string, keysAndValues []interface{}) {
+	if ls.name != "" {
+		evt.Str(LogrNameFieldName, ls.name)
+	}
+	evt.Fields(logrRender(keysAndValues)).Msg(msg)
+}
+
+// WithValues implements logr.LogSink interface.
+func (ls *logrSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	return &logrSink{
+		log:  ls.log.With().Fields(logrRender(keysAndValues)).Logger(),
+		name: ls.name,
+	}
+}
+
+// WithName implements logr.LogSink interface.
+func (ls *logrSink) WithName(name string) logr.LogSink {
+	cpy := *ls
+	if cpy.name != "" {
+		cpy.name += LogrNameSeparator + name
+	} else {
+		cpy.name = name
+	}
+	return &cpy
+}
+
+// logrRender returns copy of key value pairs with logr.Marshaler values
+// replaced by the result of MarshalLog method.
+func logrRender(keysAndValues []interface{}) []interface{} {
+	kvs := make([]interface{}, len(keysAndValues))
+	copy(kvs, keysAndValues)
+	for i := 1; i < len(kvs); i += 2 {
+		if m, ok := kvs[i].(logr.Marshaler); ok {
+			kvs[i] = m.MarshalLog()
+		}
+	}
+	return kvs
+}
+
+// ExpVerbosity tests log entry was logged by logr.Logger with V-level exp.
+// See Tester.LogrSink.
+func (ent *Entry) ExpVerbosity(exp int) {
+	ent.t.Helper()
+	ent.ExpNum(LogrVerbosityFieldName, float64(exp))
+}
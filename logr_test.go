@@ -0,0 +1,100 @@
+package zltest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	. "github.com/rzajac/zltest/internal"
+)
+
+// logrUser implements logr.Marshaler interface.
+type logrUser struct{ name string }
+
+func (u logrUser) MarshalLog() interface{} {
+	return map[string]string{"name": u.name}
+}
+
+func Test_Tester_Logr(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := tst.Logr()
+
+	// --- When ---
+	log.Info("info", "key0", "val0")
+	log.V(1).Info("debug")
+	log.V(2).Info("trace")
+	log.V(5).Info("verbose")
+	log.V(200).Info("very verbose")
+	log.Error(errors.New("test"), "error", "key1", 1)
+
+	// --- Then ---
+	ets := tst.Entries()
+	ets.ExpLen(6)
+
+	ent := ets.ExpEntry(0)
+	ent.ExpLevel(zerolog.InfoLevel)
+	ent.ExpVerbosity(0)
+	ent.ExpStr("key0", "val0")
+	ent.ExpMsg("info")
+
+	ent = ets.ExpEntry(1)
+	ent.ExpLevel(zerolog.DebugLevel)
+	ent.ExpVerbosity(1)
+
+	ent = ets.ExpEntry(2)
+	ent.ExpLevel(zerolog.TraceLevel)
+	ent.ExpVerbosity(2)
+
+	ent = ets.ExpEntry(3)
+	ent.ExpLevel(zerolog.Level(-4))
+	ent.ExpVerbosity(5)
+
+	ent = ets.ExpEntry(4)
+	ent.ExpLevel(zerolog.Level(-128))
+	ent.ExpVerbosity(200)
+
+	ent = ets.ExpEntry(5)
+	ent.ExpLevel(zerolog.ErrorLevel)
+	ent.NotExpKey(LogrVerbosityFieldName)
+	ent.ExpErr(errors.New("test"))
+	ent.ExpNum("key1", 1)
+	ent.ExpMsg("error")
+}
+
+func Test_Tester_Logr_withNameAndValues(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := tst.Logr().WithName("ctrl").WithValues("ns", "default").WithName("pod")
+
+	// --- When ---
+	log.Info("message", "user", logrUser{name: "bob"})
+	tst.Logr().Info("root")
+
+	// --- Then ---
+	ets := tst.Entries()
+	ent := ets.ExpEntry(0)
+	ent.ExpStr(LogrNameFieldName, "ctrl/pod")
+	ent.ExpStr("ns", "default")
+	ent.ExpFields(map[string]interface{}{"user": map[string]string{"name": "bob"}})
+
+	ets.ExpEntry(1).NotExpKey(LogrNameFieldName)
+	ets.ExpEntry(1).NotExpKey("ns")
+}
+
+func Test_Entry_ExpVerbosity_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Error", "expected entry key 'v' to have value '2' but got '1'")
+
+	tst := New(mck)
+	tst.Logr().V(1).Info("message")
+
+	// --- When ---
+	tst.LastEntry().ExpVerbosity(2)
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
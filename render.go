@@ -0,0 +1,141 @@
+package zltest
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// Renderer renders log entries for printing and failure messages.
+// It returns lines to print, a line may contain new line characters.
+type Renderer func(ets []*Entry) []string
+
+// RawRenderer returns Renderer printing entries as they were written
+// to the writer. It's used when no other Renderer is configured.
+func RawRenderer() Renderer {
+	return func(ets []*Entry) []string {
+		lines := make([]string, len(ets))
+		for i, ent := range ets {
+			lines[i] = ent.raw
+		}
+		return lines
+	}
+}
+
+// JSONRenderer returns Renderer printing entries as indented JSON.
+func JSONRenderer() Renderer {
+	return func(ets []*Entry) []string {
+		lines := make([]string, len(ets))
+		for i, ent := range ets {
+			buf := &bytes.Buffer{}
+			if err := json.Indent(buf, []byte(ent.raw), "", "  "); err != nil {
+				lines[i] = ent.raw
+				continue
+			}
+			lines[i] = buf.String()
+		}
+		return lines
+	}
+}
+
+// ConsoleRenderer returns Renderer printing entries in human-friendly
+// format the same way zerolog.ConsoleWriter does. When color is false the
+// output is not colorized. The timestamp is omitted for entries without
+// zerolog.TimestampFieldName field.
+func ConsoleRenderer(color bool) Renderer {
+	return func(ets []*Entry) []string {
+		lines := make([]string, len(ets))
+		for i, ent := range ets {
+			buf := &bytes.Buffer{}
+			cw := zerolog.ConsoleWriter{Out: buf, NoColor: !color}
+			if _, ok := ent.m[zerolog.TimestampFieldName]; !ok {
+				cw.PartsExclude = []string{zerolog.TimestampFieldName}
+			}
+			if _, err := cw.Write([]byte(ent.raw)); err != nil {
+				lines[i] = ent.raw
+				continue
+			}
+			lines[i] = strings.TrimSuffix(buf.String(), "\n")
+		}
+		return lines
+	}
+}
+
+// TableRenderer returns Renderer printing selected fields of entries as
+// an aligned table with a header. Missing fields are printed as "-".
+func TableRenderer(keys ...string) Renderer {
+	return func(ets []*Entry) []string {
+		rows := make([][]string, 0, len(ets)+1)
+		rows = append(rows, keys)
+		for _, ent := range ets {
+			row := make([]string, len(keys))
+			for i, key := range keys {
+				row[i] = cellValue(ent, key)
+			}
+			rows = append(rows, row)
+		}
+
+		width := make([]int, len(keys))
+		for _, row := range rows {
+			for i, cell := range row {
+				if len(cell) > width[i] {
+					width[i] = len(cell)
+				}
+			}
+		}
+
+		lines := make([]string, len(rows))
+		for r, row := range rows {
+			var b strings.Builder
+			for i, cell := range row {
+				if i > 0 {
+					b.WriteString(" | ")
+				}
+				b.WriteString(cell)
+				if i < len(row)-1 {
+					b.WriteString(strings.Repeat(" ", width[i]-len(cell)))
+				}
+			}
+			lines[r] = b.String()
+		}
+		return lines
+	}
+}
+
+// cellValue returns log entry field value formatted for a table cell.
+func cellValue(ent *Entry, key string) string {
+	itf, ok := ent.m[key]
+	if !ok {
+		return "-"
+	}
+	if str, ok := itf.(string); ok {
+		return str
+	}
+	return formatValue(itf)
+}
+
+// WithRenderer is Tester option setting Renderer used to print entries.
+func WithRenderer(r Renderer) Option {
+	return func(tst *Tester) {
+		tst.r = r
+	}
+}
+
+// renderer returns configured renderer or RawRenderer if none is set.
+func renderer(r Renderer) Renderer {
+	if r == nil {
+		return RawRenderer()
+	}
+	return r
+}
+
+// indent renders entries with r and indents every line with two spaces.
+func indent(r Renderer, ets []*Entry) []string {
+	lines := make([]string, 0, len(ets))
+	for _, line := range renderer(r)(ets) {
+		lines = append(lines, "  "+strings.Replace(line, "\n", "\n  ", -1))
+	}
+	return lines
+}
@@ -0,0 +1,199 @@
+package zltest
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/rzajac/zltest/internal"
+)
+
+func Test_JSONRenderer(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().Str("key0", "val0").Send()
+
+	// --- When ---
+	got := JSONRenderer()(tst.Entries().Get())
+
+	// --- Then ---
+	exp := []string{"{\n  \"level\": \"error\",\n  \"key0\": \"val0\"\n}"}
+	assert.Exactly(t, exp, got)
+}
+
+func Test_ConsoleRenderer(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().Str("key0", "val0").Msg("message")
+
+	// --- When ---
+	got := ConsoleRenderer(false)(tst.Entries().Get())
+
+	// --- Then ---
+	exp := []string{"ERR message key0=val0"}
+	assert.Exactly(t, exp, got)
+}
+
+func Test_ConsoleRenderer_timestamp(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().Str("time", "2021-01-02T03:04:05Z").Msg("message")
+
+	// --- When ---
+	got := ConsoleRenderer(false)(tst.Entries().Get())
+
+	// --- Then ---
+	// Timestamp is formatted in the local time zone.
+	assert.Len(t, got, 1)
+	assert.Regexp(t, `^\d{1,2}:\d{2}[AP]M ERR message$`, got[0])
+}
+
+func Test_ConsoleRenderer_color(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().Msg("message")
+
+	// --- When ---
+	got := ConsoleRenderer(true)(tst.Entries().Get())
+
+	// --- Then ---
+	assert.Len(t, got, 1)
+	assert.Contains(t, got[0], "\x1b[")
+}
+
+func Test_TableRenderer(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Info().Int("num", 1).Msg("first message")
+	log.Error().Msg("second")
+
+	// --- When ---
+	got := TableRenderer("level", "message", "num")(tst.Entries().Get())
+
+	// --- Then ---
+	exp := []string{
+		"level | message       | num",
+		"info  | first message | 1",
+		"error | second        | -",
+	}
+	assert.Exactly(t, exp, got)
+}
+
+func Test_Entries_PrintWith(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Log", "entries logged so far:")
+	mck.On("Log", "  {\n    \"level\": \"error\"\n  }")
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Error().Send()
+
+	// --- When ---
+	tst.Entries().PrintWith(JSONRenderer())
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Tester_WithRenderer(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Log", "entries logged so far:")
+	mck.On("Log", "  level | message")
+	mck.On("Log", "  error | msg")
+	mck.On(
+		"Errorf",
+		"expected no error entries but got %d:\n%s",
+		1,
+		"  level | message\n  error | msg",
+	)
+
+	tst := New(mck, WithRenderer(TableRenderer("level", "message")))
+	log := zerolog.New(tst)
+	log.Error().Msg("msg")
+
+	// --- When ---
+	tst.FilterMin(zerolog.InfoLevel).Print()
+	tst.ExpNoErrors()
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Tester_WithRenderer_failures(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected no entries with level above '%s' but got:\n%s",
+		zerolog.InfoLevel,
+		"  level | message\n  error | msg",
+	)
+	mck.On(
+		"Errorf",
+		"expected entry to have no duplicate keys but got '%s' in:\n%s",
+		"key",
+		"  level | message\n  error | msg",
+	)
+	mck.On("Error", "log assertions failed:\n"+
+		"  entry 0: level | message\n"+
+		"           error | msg:\n"+
+		"    expected other field to be present",
+	)
+
+	tst := New(mck, WithRenderer(TableRenderer("level", "message")))
+	log := zerolog.New(tst)
+	log.Error().Str("key", "a").Str("key", "b").Msg("msg")
+
+	// --- When ---
+	tst.ExpNoLevelAbove(zerolog.InfoLevel)
+	tst.ExpNoDuplicateKeys()
+	tst.Check(func(c *Checker) {
+		c.FirstEntry().ExpKey("other")
+	})
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entries_Render(t *testing.T) {
+	// --- Given ---
+	tst := New(t, WithRenderer(TableRenderer("level", "message")))
+	log := zerolog.New(tst)
+	log.Error().Msg("msg0")
+	log.Info().Msg("msg1")
+
+	// --- When ---
+	got := tst.Entries().Render()
+
+	// --- Then ---
+	exp := []string{
+		"level | message",
+		"error | msg0",
+		"info  | msg1",
+	}
+	assert.Exactly(t, exp, got)
+}
+
+func Test_Entry_Render(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+	log.Error().Msg("msg0")
+
+	// --- When ---
+	got := tst.LastEntry().Render()
+
+	// --- Then ---
+	assert.Exactly(t, []string{`{"level":"error","message":"msg0"}`}, got)
+}
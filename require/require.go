@@ -0,0 +1,165 @@
+// Package require provides the same assertions as package assert but
+// instead of returning a boolean they stop the test execution with
+// t.FailNow when the assertion fails.
+package require
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rzajac/zltest"
+	"github.com/rzajac/zltest/assert"
+)
+
+// TestingT is an interface wrapper around *testing.T.
+type TestingT = require.TestingT
+
+// tHelper is implemented by *testing.T and *testing.B.
+type tHelper interface {
+	Helper()
+}
+
+// Len requires there are n log entries.
+func Len(t TestingT, logs interface{}, n int, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if assert.Len(t, logs, n, msgAndArgs...) {
+		return
+	}
+	t.FailNow()
+}
+
+// Logged requires at least one log entry matches m.
+func Logged(t TestingT, logs interface{}, m zltest.Matcher, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if assert.Logged(t, logs, m, msgAndArgs...) {
+		return
+	}
+	t.FailNow()
+}
+
+// NotLogged requires no log entry matches m.
+func NotLogged(t TestingT, logs interface{}, m zltest.Matcher, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if assert.NotLogged(t, logs, m, msgAndArgs...) {
+		return
+	}
+	t.FailNow()
+}
+
+// Msg requires at least one log entry has message msg.
+func Msg(t TestingT, logs interface{}, msg string, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if assert.Msg(t, logs, msg, msgAndArgs...) {
+		return
+	}
+	t.FailNow()
+}
+
+// NotMsg requires no log entry has message msg.
+func NotMsg(t TestingT, logs interface{}, msg string, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if assert.NotMsg(t, logs, msg, msgAndArgs...) {
+		return
+	}
+	t.FailNow()
+}
+
+// Str requires at least one log entry has a string field key equal to exp.
+func Str(t TestingT, logs interface{}, key, exp string, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if assert.Str(t, logs, key, exp, msgAndArgs...) {
+		return
+	}
+	t.FailNow()
+}
+
+// Num requires at least one log entry has a number field key equal to exp.
+func Num(t TestingT, logs interface{}, key string, exp float64, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if assert.Num(t, logs, key, exp, msgAndArgs...) {
+		return
+	}
+	t.FailNow()
+}
+
+// Bool requires at least one log entry has a boolean field key equal to exp.
+func Bool(t TestingT, logs interface{}, key string, exp bool, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if assert.Bool(t, logs, key, exp, msgAndArgs...) {
+		return
+	}
+	t.FailNow()
+}
+
+// Error requires at least one log entry has error field
+// (zerolog.ErrorFieldName) equal to exp.
+func Error(t TestingT, logs interface{}, exp string, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if assert.Error(t, logs, exp, msgAndArgs...) {
+		return
+	}
+	t.FailNow()
+}
+
+// Level requires at least one log entry was logged at level.
+func Level(t TestingT, logs interface{}, level zerolog.Level, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if assert.Level(t, logs, level, msgAndArgs...) {
+		return
+	}
+	t.FailNow()
+}
+
+// NoLevelAbove requires no log entry was logged at level above level.
+func NoLevelAbove(t TestingT, logs interface{}, level zerolog.Level, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if assert.NoLevelAbove(t, logs, level, msgAndArgs...) {
+		return
+	}
+	t.FailNow()
+}
+
+// NoErrors requires no log entry was logged at zerolog.ErrorLevel or above.
+func NoErrors(t TestingT, logs interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if assert.NoErrors(t, logs, msgAndArgs...) {
+		return
+	}
+	t.FailNow()
+}
+
+// NoDuplicateKeys requires no log entry has a field key written more than
+// once.
+func NoDuplicateKeys(t TestingT, logs interface{}, msgAndArgs ...interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if assert.NoDuplicateKeys(t, logs, msgAndArgs...) {
+		return
+	}
+	t.FailNow()
+}
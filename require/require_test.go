@@ -0,0 +1,134 @@
+package require
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	trequire "github.com/stretchr/testify/require"
+
+	"github.com/rzajac/zltest"
+)
+
+// recT implements TestingT recording failures.
+type recT struct {
+	msgs    []string
+	failNow bool
+}
+
+func (rt *recT) Errorf(format string, args ...interface{}) {
+	rt.msgs = append(rt.msgs, fmt.Sprintf(format, args...))
+}
+
+func (rt *recT) FailNow() { rt.failNow = true }
+
+// anyKey matches log entries with field "key".
+func anyKey(ent *zltest.Entry) bool { return ent.HasKey("key") }
+
+// none matches no log entries.
+func none(*zltest.Entry) bool { return false }
+
+func Test_Requirements(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		pass func(rt TestingT, tst *zltest.Tester)
+		fail func(rt TestingT, tst *zltest.Tester)
+	}{
+		{
+			"Len",
+			func(rt TestingT, tst *zltest.Tester) { Len(rt, tst, 1) },
+			func(rt TestingT, tst *zltest.Tester) { Len(rt, tst, 2) },
+		},
+		{
+			"Logged",
+			func(rt TestingT, tst *zltest.Tester) { Logged(rt, tst, anyKey) },
+			func(rt TestingT, tst *zltest.Tester) { Logged(rt, tst, none) },
+		},
+		{
+			"NotLogged",
+			func(rt TestingT, tst *zltest.Tester) { NotLogged(rt, tst, none) },
+			func(rt TestingT, tst *zltest.Tester) { NotLogged(rt, tst, anyKey) },
+		},
+		{
+			"Msg",
+			func(rt TestingT, tst *zltest.Tester) { Msg(rt, tst, "msg0") },
+			func(rt TestingT, tst *zltest.Tester) { Msg(rt, tst, "msg1", "custom") },
+		},
+		{
+			"NotMsg",
+			func(rt TestingT, tst *zltest.Tester) { NotMsg(rt, tst, "msg1") },
+			func(rt TestingT, tst *zltest.Tester) { NotMsg(rt, tst, "msg0") },
+		},
+		{
+			"Str",
+			func(rt TestingT, tst *zltest.Tester) { Str(rt, tst, "key", "val") },
+			func(rt TestingT, tst *zltest.Tester) { Str(rt, tst, "key", "other") },
+		},
+		{
+			"Num",
+			func(rt TestingT, tst *zltest.Tester) { Num(rt, tst, "num", 1) },
+			func(rt TestingT, tst *zltest.Tester) { Num(rt, tst, "num", 2) },
+		},
+		{
+			"Bool",
+			func(rt TestingT, tst *zltest.Tester) { Bool(rt, tst, "bool", true) },
+			func(rt TestingT, tst *zltest.Tester) { Bool(rt, tst, "bool", false) },
+		},
+		{
+			"Error",
+			func(rt TestingT, tst *zltest.Tester) { Error(rt, tst, "err") },
+			func(rt TestingT, tst *zltest.Tester) { Error(rt, tst, "other") },
+		},
+		{
+			"Level",
+			func(rt TestingT, tst *zltest.Tester) { Level(rt, tst, zerolog.ErrorLevel) },
+			func(rt TestingT, tst *zltest.Tester) { Level(rt, tst, zerolog.InfoLevel) },
+		},
+		{
+			"NoLevelAbove",
+			func(rt TestingT, tst *zltest.Tester) { NoLevelAbove(rt, tst, zerolog.ErrorLevel) },
+			func(rt TestingT, tst *zltest.Tester) { NoLevelAbove(rt, tst, zerolog.InfoLevel) },
+		},
+		{
+			"NoErrors",
+			func(rt TestingT, tst *zltest.Tester) { NoErrors(rt, tst.Entries().Filter(zerolog.InfoLevel)) },
+			func(rt TestingT, tst *zltest.Tester) { NoErrors(rt, tst) },
+		},
+		{
+			"NoDuplicateKeys",
+			func(rt TestingT, tst *zltest.Tester) { NoDuplicateKeys(rt, tst) },
+			func(rt TestingT, tst *zltest.Tester) {
+				log := zerolog.New(tst)
+				log.Info().Str("key", "a").Str("key", "b").Send()
+				NoDuplicateKeys(rt, tst)
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			tst := zltest.New(t)
+			log := zerolog.New(tst)
+			log.Error().
+				Str("key", "val").
+				Int("num", 1).
+				Bool("bool", true).
+				Str("error", "err").
+				Msg("msg0")
+
+			// --- When ---
+			pass, fail := &recT{}, &recT{}
+			tc.pass(pass, tst)
+			tc.fail(fail, tst)
+
+			// --- Then ---
+			assert.False(t, pass.failNow)
+			assert.Empty(t, pass.msgs)
+			assert.True(t, fail.failNow)
+			trequire.Len(t, fail.msgs, 1)
+		})
+	}
+}
@@ -0,0 +1,469 @@
+package zltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ExpSchema tests that every log entry is valid against JSON Schema.
+// See Entries.ExpSchema.
+func (tst *Tester) ExpSchema(schema string) {
+	tst.t.Helper()
+	tst.Entries().ExpSchema(schema)
+}
+
+// ExpSchema tests that every log entry is valid against JSON Schema. It
+// reports one failure per invalid entry listing all violations with JSON
+// pointers to the offending values.
+//
+// A subset of draft 2020-12 is supported: type, enum, const, required,
+// properties, additionalProperties, items, pattern, minimum, maximum,
+// minLength, maxLength, minItems, maxItems, allOf, anyOf, oneOf, not and
+// $ref pointing to the same document (for example "#/$defs/user"). Other
+// keywords are ignored. Invalid schema is reported with a call to Fatal.
+func (ets Entries) ExpSchema(schema string) {
+	ets.t.Helper()
+	sch, err := compileSchema(schema)
+	if err != nil {
+		ets.t.Fatal(err)
+		return
+	}
+	for i, ent := range ets.e {
+		vs := sch.validate("#", ent.m)
+		if len(vs) == 0 {
+			continue
+		}
+		ets.t.Errorf(
+			"expected entry %d to match schema:\n  %s\nin:\n%s",
+			i,
+			strings.Join(vs, "\n  "),
+			strings.Join(indent(ets.r, []*Entry{ent}), "\n"),
+		)
+	}
+}
+
+// schema represents compiled JSON Schema.
+type schema struct {
+	always   *bool              // Set for boolean schemas.
+	ref      *schema            // Resolved $ref.
+	types    []string           // Allowed JSON types.
+	enum     []interface{}      // Allowed values.
+	cnst     interface{}        // Required value.
+	hasConst bool               // Set when const keyword is present.
+	required []string           // Required object keys.
+	props    map[string]*schema // Object property schemas.
+	addProps *schema            // Schema for keys not in props.
+	items    *schema            // Schema for array items.
+	pattern  *regexp.Regexp     // Pattern strings must match.
+	minimum  *float64           // Minimum number value.
+	maximum  *float64           // Maximum number value.
+	minLen   *float64           // Minimum string length in characters.
+	maxLen   *float64           // Maximum string length in characters.
+	minItems *float64           // Minimum array length.
+	maxItems *float64           // Maximum array length.
+	allOf    []*schema          // Schemas the value must match.
+	anyOf    []*schema          // Schemas the value must match at least one.
+	oneOf    []*schema          // Schemas the value must match exactly one.
+	not      *schema            // Schema the value must not match.
+}
+
+// schemaCompiler compiles JSON Schema documents.
+type schemaCompiler struct {
+	root interface{}        // Decoded schema document.
+	refs map[string]*schema // Compiled schemas by $ref.
+}
+
+// compileSchema compiles JSON Schema document.
+func compileSchema(doc string) (*schema, error) {
+	var root interface{}
+	if err := json.Unmarshal([]byte(doc), &root); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	c := &schemaCompiler{root: root, refs: make(map[string]*schema)}
+	return c.compile("#", root)
+}
+
+// compile compiles schema node at JSON pointer ptr.
+func (c *schemaCompiler) compile(ptr string, node interface{}) (*schema, error) {
+	if b, ok := node.(bool); ok {
+		return &schema{always: &b}, nil
+	}
+	obj, ok := node.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid schema at '%s': expected object or boolean", ptr)
+	}
+
+	sch := &schema{}
+	var err error
+	for _, kw := range sortedKeys(obj) {
+		val := obj[kw]
+		at := ptr + "/" + escapePointer(kw)
+		switch kw {
+		case "$ref":
+			sch.ref, err = c.resolve(at, val)
+		case "type":
+			if sch.types, err = schemaStrings(at, val); err == nil {
+				err = checkTypes(at, sch.types)
+			}
+		case "enum":
+			arr, ok := val.([]interface{})
+			if !ok {
+				err = fmt.Errorf("invalid schema at '%s': expected array", at)
+			}
+			sch.enum = arr
+		case "const":
+			sch.cnst, sch.hasConst = val, true
+		case "required":
+			sch.required, err = schemaStrings(at, val)
+		case "properties":
+			sch.props, err = c.compileMap(at, val)
+		case "additionalProperties":
+			sch.addProps, err = c.compile(at, val)
+		case "items":
+			sch.items, err = c.compile(at, val)
+		case "pattern":
+			str, ok := val.(string)
+			if !ok {
+				err = fmt.Errorf("invalid schema at '%s': expected string", at)
+				break
+			}
+			sch.pattern, err = regexp.Compile(str)
+		case "minimum":
+			sch.minimum, err = schemaNumber(at, val)
+		case "maximum":
+			sch.maximum, err = schemaNumber(at, val)
+		case "minLength":
+			sch.minLen, err = schemaNumber(at, val)
+		case "maxLength":
+			sch.maxLen, err = schemaNumber(at, val)
+		case "minItems":
+			sch.minItems, err = schemaNumber(at, val)
+		case "maxItems":
+			sch.maxItems, err = schemaNumber(at, val)
+		case "allOf":
+			sch.allOf, err = c.compileList(at, val)
+		case "anyOf":
+			sch.anyOf, err = c.compileList(at, val)
+		case "oneOf":
+			sch.oneOf, err = c.compileList(at, val)
+		case "not":
+			sch.not, err = c.compile(at, val)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sch, nil
+}
+
+// compileMap compiles object of schemas at JSON pointer ptr.
+func (c *schemaCompiler) compileMap(ptr string, node interface{}) (map[string]*schema, error) {
+	obj, ok := node.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid schema at '%s': expected object", ptr)
+	}
+	schs := make(map[string]*schema, len(obj))
+	for key, val := range obj {
+		sch, err := c.compile(ptr+"/"+escapePointer(key), val)
+		if err != nil {
+			return nil, err
+		}
+		schs[key] = sch
+	}
+	return schs, nil
+}
+
+// compileList compiles array of schemas at JSON pointer ptr.
+func (c *schemaCompiler) compileList(ptr string, node interface{}) ([]*schema, error) {
+	arr, ok := node.([]interface{})
+	if !ok || len(arr) == 0 {
+		return nil, fmt.Errorf("invalid schema at '%s': expected non-empty array", ptr)
+	}
+	schs := make([]*schema, len(arr))
+	for i, val := range arr {
+		sch, err := c.compile(fmt.Sprintf("%s/%d", ptr, i), val)
+		if err != nil {
+			return nil, err
+		}
+		schs[i] = sch
+	}
+	return schs, nil
+}
+
+// resolve compiles schema referenced by $ref. Only references to the same
+// document are supported.
+func (c *schemaCompiler) resolve(ptr string, node interface{}) (*schema, error) {
+	ref, ok := node.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid schema at '%s': expected string", ptr)
+	}
+	if sch, ok := c.refs[ref]; ok {
+		return sch, nil
+	}
+	if ref != "#" && !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("invalid schema at '%s': unsupported $ref '%s'", ptr, ref)
+	}
+
+	target := c.root
+	if ref != "#" {
+		for _, tok := range strings.Split(ref[2:], "/") {
+			tok = strings.Replace(tok, "~1", "/", -1)
+			tok = strings.Replace(tok, "~0", "~", -1)
+			obj, ok := target.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid schema at '%s': cannot resolve $ref '%s'", ptr, ref)
+			}
+			if target, ok = obj[tok]; !ok {
+				return nil, fmt.Errorf("invalid schema at '%s': cannot resolve $ref '%s'", ptr, ref)
+			}
+		}
+	}
+
+	// Register the schema before compiling it to support recursive schemas.
+	sch := &schema{}
+	c.refs[ref] = sch
+	cmp, err := c.compile(ref, target)
+	if err != nil {
+		return nil, err
+	}
+	*sch = *cmp
+	return sch, nil
+}
+
+// schemaStrings returns schema keyword value as a slice of strings. The
+// value may be a string or an array of strings.
+func schemaStrings(ptr string, node interface{}) ([]string, error) {
+	if str, ok := node.(string); ok {
+		return []string{str}, nil
+	}
+	arr, ok := node.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid schema at '%s': expected string or array of strings", ptr)
+	}
+	strs := make([]string, len(arr))
+	for i, val := range arr {
+		if strs[i], ok = val.(string); !ok {
+			return nil, fmt.Errorf("invalid schema at '%s': expected string or array of strings", ptr)
+		}
+	}
+	return strs, nil
+}
+
+// checkTypes returns error if any of the types is not a JSON Schema type.
+func checkTypes(ptr string, types []string) error {
+	for _, typ := range types {
+		switch typ {
+		case "null", "boolean", "object", "array", "number", "integer", "string":
+		default:
+			return fmt.Errorf("invalid schema at '%s': unknown type '%s'", ptr, typ)
+		}
+	}
+	return nil
+}
+
+// schemaNumber returns schema keyword value as a number.
+func schemaNumber(ptr string, node interface{}) (*float64, error) {
+	num, ok := node.(float64)
+	if !ok {
+		return nil, fmt.Errorf("invalid schema at '%s': expected number", ptr)
+	}
+	return &num, nil
+}
+
+// validate validates value v at JSON pointer ptr and returns violations.
+func (sch *schema) validate(ptr string, v interface{}) []string {
+	if sch.always != nil {
+		if *sch.always {
+			return []string{}
+		}
+		return []string{ptr + ": value is not allowed"}
+	}
+
+	vs := make([]string, 0)
+	fail := func(format string, args ...interface{}) {
+		vs = append(vs, ptr+": "+fmt.Sprintf(format, args...))
+	}
+
+	if sch.ref != nil {
+		vs = append(vs, sch.ref.validate(ptr, v)...)
+	}
+	if len(sch.types) > 0 && !matchType(sch.types, v) {
+		if len(sch.types) == 1 {
+			fail("expected type '%s' but got '%s'", sch.types[0], jsonType(v))
+		} else {
+			fail("expected one of types %s but got '%s'", joinKeys(sch.types), jsonType(v))
+		}
+	}
+	if sch.enum != nil && !containsValue(sch.enum, v) {
+		fail("value %s is not one of %s", formatValue(v), formatValue(sch.enum))
+	}
+	if sch.hasConst && !reflect.DeepEqual(sch.cnst, v) {
+		fail("expected value %s but got %s", formatValue(sch.cnst), formatValue(v))
+	}
+
+	switch val := v.(type) {
+	case map[string]interface{}:
+		vs = append(vs, sch.validateObject(ptr, val)...)
+	case []interface{}:
+		vs = append(vs, sch.validateArray(ptr, val)...)
+	case string:
+		n := float64(utf8.RuneCountInString(val))
+		if sch.pattern != nil && !sch.pattern.MatchString(val) {
+			fail("value '%s' does not match pattern '%s'", val, sch.pattern)
+		}
+		if sch.minLen != nil && n < *sch.minLen {
+			fail("string length %v is less than %v", n, *sch.minLen)
+		}
+		if sch.maxLen != nil && n > *sch.maxLen {
+			fail("string length %v is greater than %v", n, *sch.maxLen)
+		}
+	case float64:
+		if sch.minimum != nil && val < *sch.minimum {
+			fail("value %v is less than minimum %v", val, *sch.minimum)
+		}
+		if sch.maximum != nil && val > *sch.maximum {
+			fail("value %v is greater than maximum %v", val, *sch.maximum)
+		}
+	}
+
+	for _, sub := range sch.allOf {
+		vs = append(vs, sub.validate(ptr, v)...)
+	}
+	if sch.anyOf != nil && countValid(sch.anyOf, ptr, v) == 0 {
+		fail("value does not match any schema in anyOf")
+	}
+	if sch.oneOf != nil {
+		if n := countValid(sch.oneOf, ptr, v); n != 1 {
+			fail("value matches %d schemas in oneOf, expected exactly one", n)
+		}
+	}
+	if sch.not != nil && len(sch.not.validate(ptr, v)) == 0 {
+		fail("value must not match schema in not")
+	}
+	return vs
+}
+
+// validateObject validates object keywords.
+func (sch *schema) validateObject(ptr string, obj map[string]interface{}) []string {
+	vs := make([]string, 0)
+	for _, key := range sch.required {
+		if _, ok := obj[key]; !ok {
+			vs = append(vs, fmt.Sprintf("%s: missing required key '%s'", ptr, key))
+		}
+	}
+	for _, key := range sortedKeys(obj) {
+		at := ptr + "/" + escapePointer(key)
+		if sub, ok := sch.props[key]; ok {
+			vs = append(vs, sub.validate(at, obj[key])...)
+			continue
+		}
+		if sch.addProps == nil {
+			continue
+		}
+		if f := sch.addProps.always; f != nil && !*f {
+			vs = append(vs, fmt.Sprintf("%s: key '%s' is not allowed", ptr, key))
+			continue
+		}
+		vs = append(vs, sch.addProps.validate(at, obj[key])...)
+	}
+	return vs
+}
+
+// validateArray validates array keywords.
+func (sch *schema) validateArray(ptr string, arr []interface{}) []string {
+	vs := make([]string, 0)
+	n := float64(len(arr))
+	if sch.minItems != nil && n < *sch.minItems {
+		vs = append(vs, fmt.Sprintf("%s: array length %v is less than %v", ptr, n, *sch.minItems))
+	}
+	if sch.maxItems != nil && n > *sch.maxItems {
+		vs = append(vs, fmt.Sprintf("%s: array length %v is greater than %v", ptr, n, *sch.maxItems))
+	}
+	if sch.items != nil {
+		for i, item := range arr {
+			vs = append(vs, sch.items.validate(fmt.Sprintf("%s/%d", ptr, i), item)...)
+		}
+	}
+	return vs
+}
+
+// countValid returns number of schemas v is valid against.
+func countValid(schs []*schema, ptr string, v interface{}) int {
+	var cnt int
+	for _, sch := range schs {
+		if len(sch.validate(ptr, v)) == 0 {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// matchType returns true if v is of one of JSON Schema types.
+func matchType(types []string, v interface{}) bool {
+	have := jsonType(v)
+	for _, typ := range types {
+		if typ == have {
+			return true
+		}
+		if typ == "number" && have == "integer" {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonType returns JSON Schema type name of decoded JSON value. Numbers
+// without fractional part are reported as integers.
+func jsonType(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		if val == math.Trunc(val) {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// containsValue returns true if values contain v.
+func containsValue(values []interface{}, v interface{}) bool {
+	for _, val := range values {
+		if reflect.DeepEqual(val, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys returns sorted keys of the map.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// escapePointer escapes JSON pointer reference token.
+func escapePointer(tok string) string {
+	tok = strings.Replace(tok, "~", "~0", -1)
+	return strings.Replace(tok, "/", "~1", -1)
+}
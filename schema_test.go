@@ -0,0 +1,181 @@
+package zltest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/rzajac/zltest/internal"
+)
+
+const testSchema = `{
+	"type": "object",
+	"required": ["level", "message", "user"],
+	"properties": {
+		"level": {"enum": ["debug", "info", "warn", "error"]},
+		"message": {"type": "string", "minLength": 1},
+		"user": {"$ref": "#/$defs/user"},
+		"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 2},
+		"amount": {"type": "number", "minimum": 0}
+	},
+	"additionalProperties": false,
+	"$defs": {
+		"user": {
+			"type": "object",
+			"required": ["id"],
+			"properties": {
+				"id": {"type": "string", "pattern": "^u[0-9]+$"},
+				"age": {"type": "integer"}
+			}
+		}
+	}
+}`
+
+func Test_Tester_ExpSchema(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Info().
+		Dict("user", zerolog.Dict().Str("id", "u1").Int("age", 42)).
+		Strs("tags", []string{"a", "b"}).
+		Float64("amount", 1.5).
+		Msg("msg")
+	log.Error().Dict("user", zerolog.Dict().Str("id", "u2")).Msg("msg")
+
+	// --- Then ---
+	tst.ExpSchema(testSchema)
+}
+
+func Test_Tester_ExpSchema_violations(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry %d to match schema:\n  %s\nin:\n%s",
+		1,
+		"#: missing required key 'message'\n"+
+			"  #/amount: value -1 is less than minimum 0\n"+
+			"  #: key 'other' is not allowed\n"+
+			"  #/tags: array length 3 is greater than 2\n"+
+			"  #/tags/1: expected type 'string' but got 'integer'\n"+
+			"  #/user/age: expected type 'integer' but got 'number'\n"+
+			"  #/user/id: value 'x1' does not match pattern '^u[0-9]+$'",
+		`  {"level":"info","user":{"id":"x1","age":1.5},"tags":["a",1,"c"],"amount":-1,"other":1}`,
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Info().Dict("user", zerolog.Dict().Str("id", "u1")).Msg("msg")
+	log.Info().
+		Dict("user", zerolog.Dict().Str("id", "x1").Float64("age", 1.5)).
+		Interface("tags", []interface{}{"a", 1, "c"}).
+		Int("amount", -1).
+		Int("other", 1).
+		Send()
+
+	// --- When ---
+	tst.ExpSchema(testSchema)
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Entries_ExpSchema_badSchema(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		schema string
+	}{
+		{"json", "{ bad json }"},
+		{"node", `{"properties": {"key": 1}}`},
+		{"pattern", `{"pattern": "("}`},
+		{"remote ref", `{"$ref": "https://example.com/schema.json"}`},
+		{"missing ref", `{"$ref": "#/$defs/missing"}`},
+		{"type", `{"type": 1}`},
+		{"unknown type", `{"type": "numbr"}`},
+		{"minimum", `{"minimum": "1"}`},
+		{"anyOf", `{"anyOf": []}`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			mck := &TMock{}
+			mck.On("Helper")
+			mck.On("Fatal", mock.Anything)
+
+			tst := New(mck)
+			log := zerolog.New(tst)
+			log.Info().Msg("msg")
+
+			// --- When ---
+			tst.Entries().ExpSchema(tc.schema)
+
+			// --- Then ---
+			mck.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_schema_validate(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		schema string
+		val    string
+		exp    []string
+	}{
+		{"true", `true`, `1`, []string{}},
+		{"false", `false`, `1`, []string{"#: value is not allowed"}},
+		{"types", `{"type": ["string", "null"]}`, `null`, []string{}},
+		{"types error", `{"type": ["string", "null"]}`, `1`, []string{
+			"#: expected one of types ['string', 'null'] but got 'integer'",
+		}},
+		{"integer is number", `{"type": "number"}`, `1`, []string{}},
+		{"const", `{"const": {"a": 1}}`, `{"a": 1}`, []string{}},
+		{"const error", `{"const": "a"}`, `"b"`, []string{`#: expected value "a" but got "b"`}},
+		{"enum error", `{"enum": [1, 2]}`, `3`, []string{"#: value 3 is not one of [1,2]"}},
+		{"maxLength", `{"maxLength": 2}`, `"abc"`, []string{"#: string length 3 is greater than 2"}},
+		{"minItems", `{"minItems": 1}`, `[]`, []string{"#: array length 0 is less than 1"}},
+		{"maximum", `{"maximum": 1}`, `2`, []string{"#: value 2 is greater than maximum 1"}},
+		{"anyOf", `{"anyOf": [{"type": "string"}, {"type": "null"}]}`, `null`, []string{}},
+		{"anyOf error", `{"anyOf": [{"type": "string"}, {"type": "null"}]}`, `1`, []string{
+			"#: value does not match any schema in anyOf",
+		}},
+		{"oneOf error", `{"oneOf": [{"type": "number"}, {"type": "integer"}]}`, `1`, []string{
+			"#: value matches 2 schemas in oneOf, expected exactly one",
+		}},
+		{"allOf error", `{"allOf": [{"type": "number"}, {"minimum": 2}]}`, `1`, []string{
+			"#: value 1 is less than minimum 2",
+		}},
+		{"not error", `{"not": {"type": "string"}}`, `"a"`, []string{"#: value must not match schema in not"}},
+		{"additionalProperties schema", `{"additionalProperties": {"type": "string"}}`, `{"a~/b": 1}`, []string{
+			"#/a~0~1b: expected type 'string' but got 'integer'",
+		}},
+		{"recursive ref", `{"properties": {"next": {"$ref": "#"}}, "required": ["v"]}`, `{"v": 1, "next": {}}`, []string{
+			"#/next: missing required key 'v'",
+		}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			sch, err := compileSchema(tc.schema)
+			assert.NoError(t, err)
+			var val interface{}
+			assert.NoError(t, json.Unmarshal([]byte(tc.val), &val))
+
+			// --- When ---
+			got := sch.validate("#", val)
+
+			// --- Then ---
+			assert.Exactly(t, tc.exp, got)
+		})
+	}
+}
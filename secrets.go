@@ -0,0 +1,226 @@
+package zltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Regular expressions used by secret detectors.
+var (
+	bearerRx     = regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9\-._~+/]{20,}=*)`)
+	bearerCharRx = regexp.MustCompile(`[0-9\-._~+/]`)
+	jwtRx        = regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`)
+	awsKeyRx     = regexp.MustCompile(`\b(?:AKIA|ASIA|AGPA|AIDA|AROA)[0-9A-Z]{16}\b`)
+	cardRx       = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+	emailRx      = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	privateKeyRx = regexp.MustCompile(`-----BEGIN (?:[A-Z0-9]+ )*PRIVATE KEY-----`)
+)
+
+// Detector detects secrets in log entry fields. It receives field key and
+// its JSON decoded value and returns the name of detected secret or empty
+// string. Numbers are passed as json.Number. Arrays and objects are passed
+// as they are and their elements are passed separately, array elements with
+// empty key so key based detectors report the array field only once.
+type Detector func(key string, val interface{}) string
+
+// DetectBearer returns Detector finding bearer tokens. The token must be at
+// least 20 characters long and contain a digit or one of "-._~+/" so prose
+// like "missing bearer token" is not reported.
+func DetectBearer() Detector {
+	return func(_ string, val interface{}) string {
+		str, ok := val.(string)
+		if !ok {
+			return ""
+		}
+		for _, match := range bearerRx.FindAllStringSubmatch(str, -1) {
+			if bearerCharRx.MatchString(match[1]) {
+				return "bearer token"
+			}
+		}
+		return ""
+	}
+}
+
+// DetectJWT returns Detector finding JSON Web Tokens.
+func DetectJWT() Detector {
+	return DetectRegexp("JWT", jwtRx)
+}
+
+// DetectAWSKeys returns Detector finding AWS access key IDs.
+func DetectAWSKeys() Detector {
+	return DetectRegexp("AWS access key", awsKeyRx)
+}
+
+// DetectCards returns Detector finding credit card numbers in string values.
+// Digits may be separated by spaces or dashes, candidates must pass the Luhn
+// check. Numbers are not checked because about one in ten integers of card
+// number length, for example Unix timestamps in milliseconds, pass it.
+func DetectCards() Detector {
+	return func(_ string, val interface{}) string {
+		str, ok := val.(string)
+		if !ok {
+			return ""
+		}
+		for _, cand := range cardRx.FindAllString(str, -1) {
+			if luhn(cand) {
+				return "credit card number"
+			}
+		}
+		return ""
+	}
+}
+
+// DetectEmails returns Detector finding email addresses.
+func DetectEmails() Detector {
+	return DetectRegexp("email address", emailRx)
+}
+
+// DetectPrivateKeys returns Detector finding PEM encoded private keys.
+func DetectPrivateKeys() Detector {
+	return DetectRegexp("private key", privateKeyRx)
+}
+
+// DetectRegexp returns Detector reporting name when rx matches string field
+// value or JSON text of a number.
+func DetectRegexp(name string, rx *regexp.Regexp) Detector {
+	return func(_ string, val interface{}) string {
+		switch v := val.(type) {
+		case string:
+			if rx.MatchString(v) {
+				return name
+			}
+		case json.Number:
+			if rx.MatchString(v.String()) {
+				return name
+			}
+		}
+		return ""
+	}
+}
+
+// DetectKeys returns Detector reporting fields with forbidden keys, for
+// example "password". Keys are compared case-insensitively.
+func DetectKeys(keys ...string) Detector {
+	return func(key string, _ interface{}) string {
+		for _, k := range keys {
+			if strings.EqualFold(k, key) {
+				return fmt.Sprintf("forbidden key '%s'", key)
+			}
+		}
+		return ""
+	}
+}
+
+// DefaultDetectors returns detectors used by ExpNoSecrets when none are
+// given.
+func DefaultDetectors() []Detector {
+	return []Detector{
+		DetectBearer(),
+		DetectJWT(),
+		DetectAWSKeys(),
+		DetectCards(),
+		DetectEmails(),
+		DetectPrivateKeys(),
+	}
+}
+
+// FailOnSecrets registers T.Cleanup function which calls ExpNoSecrets with
+// given detectors when the test completes.
+func FailOnSecrets(detectors ...Detector) Option {
+	return func(tst *Tester) {
+		tst.t.Cleanup(func() {
+			tst.t.Helper()
+			tst.ExpNoSecrets(detectors...)
+		})
+	}
+}
+
+// ExpNoSecrets tests that no log entry field, including fields of nested
+// objects and arrays, contains a secret found by any of the detectors. When
+// no detectors are given DefaultDetectors are used. The failure message
+// lists entry index, JSON pointer to the field and the secret name, it
+// never includes the secret itself. It calls Fatal when any of the log
+// entries cannot be decoded.
+func (tst *Tester) ExpNoSecrets(detectors ...Detector) {
+	tst.t.Helper()
+	if len(detectors) == 0 {
+		detectors = DefaultDetectors()
+	}
+
+	found := make([]string, 0)
+	for i, ent := range tst.Entries().Get() {
+		dec := json.NewDecoder(strings.NewReader(ent.raw))
+		dec.UseNumber()
+		var v interface{}
+		if err := dec.Decode(&v); err != nil {
+			tst.t.Fatal(err)
+			return
+		}
+		walkFields("#", "", v, func(ptr, key string, val interface{}) {
+			for _, det := range detectors {
+				if name := det(key, val); name != "" {
+					found = append(found, fmt.Sprintf("  entry %d %s: %s", i, ptr, name))
+				}
+			}
+		})
+	}
+
+	if len(found) > 0 {
+		tst.t.Errorf(
+			"expected no secrets in log entries but found %d:\n%s",
+			len(found),
+			strings.Join(found, "\n"),
+		)
+	}
+}
+
+// walkFields calls f for value v at JSON pointer ptr with key and for all
+// nested values. See Detector for how values are passed to f.
+func walkFields(ptr, key string, v interface{}, f func(ptr, key string, val interface{})) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		if ptr != "#" {
+			f(ptr, key, val)
+		}
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			walkFields(ptr+"/"+escapePointer(k), k, val[k], f)
+		}
+	case []interface{}:
+		f(ptr, key, val)
+		for i, item := range val {
+			walkFields(fmt.Sprintf("%s/%d", ptr, i), "", item, f)
+		}
+	default:
+		f(ptr, key, val)
+	}
+}
+
+// luhn returns true if digits in s pass the Luhn checksum. Characters
+// other than digits are ignored.
+func luhn(s string) bool {
+	var sum, n int
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if n%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		n++
+	}
+	return n > 0 && sum%10 == 0
+}
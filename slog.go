@@ -0,0 +1,172 @@
+//go:build go1.21
+// +build go1.21
+
+package zltest
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/rs/zerolog"
+)
+
+// SlogHandler returns slog.Handler writing log records to the Tester as
+// zerolog entries, so they can be tested with the same Entry and Entries
+// methods. Record level is mapped to the closest lower zerolog level, groups
+// are written as nested objects.
+func (tst *Tester) SlogHandler() slog.Handler {
+	return &slogHandler{
+		log:   zerolog.New(tst),
+		attrs: make([][]slog.Attr, 1),
+	}
+}
+
+// Slog returns slog.Logger using SlogHandler.
+func (tst *Tester) Slog() *slog.Logger {
+	return slog.New(tst.SlogHandler())
+}
+
+// slogHandler implements slog.Handler writing to zerolog.Logger.
+type slogHandler struct {
+	log    zerolog.Logger // Logger writing to the Tester.
+	groups []string       // Open groups.
+	attrs  [][]slog.Attr  // Attributes added with WithAttrs per group depth.
+}
+
+// Enabled implements slog.Handler interface. All levels are enabled.
+func (h *slogHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+// Handle implements slog.Handler interface.
+func (h *slogHandler) Handle(_ context.Context, r slog.Record) error {
+	rec := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		rec = append(rec, a)
+		return true
+	})
+
+	evt := h.log.WithLevel(slogLevel(r.Level))
+	if !r.Time.IsZero() {
+		evt.Time(zerolog.TimestampFieldName, r.Time)
+	}
+	for _, a := range h.build(0, rec) {
+		appendAttr(evt, a)
+	}
+	evt.Msg(r.Message)
+	return nil
+}
+
+// WithAttrs implements slog.Handler interface.
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	cpy := h.clone()
+	depth := len(cpy.groups)
+	cpy.attrs[depth] = append(cpy.attrs[depth], attrs...)
+	return cpy
+}
+
+// WithGroup implements slog.Handler interface.
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	cpy := h.clone()
+	cpy.groups = append(cpy.groups, name)
+	cpy.attrs = append(cpy.attrs, nil)
+	return cpy
+}
+
+// clone returns deep copy of the handler.
+func (h *slogHandler) clone() *slogHandler {
+	cpy := &slogHandler{
+		log:    h.log,
+		groups: append([]string{}, h.groups...),
+		attrs:  make([][]slog.Attr, len(h.attrs)),
+	}
+	for i, attrs := range h.attrs {
+		cpy.attrs[i] = append([]slog.Attr{}, attrs...)
+	}
+	return cpy
+}
+
+// build returns attributes at group depth with nested groups. The rec
+// attributes are placed in the innermost group.
+func (h *slogHandler) build(depth int, rec []slog.Attr) []slog.Attr {
+	attrs := append([]slog.Attr{}, h.attrs[depth]...)
+	if depth == len(h.groups) {
+		return append(attrs, rec...)
+	}
+	if sub := h.build(depth+1, rec); len(sub) > 0 {
+		attrs = append(attrs, slog.Attr{
+			Key:   h.groups[depth],
+			Value: slog.GroupValue(sub...),
+		})
+	}
+	return attrs
+}
+
+// appendAttr adds attribute to zerolog event.
+func appendAttr(evt *zerolog.Event, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+
+	switch a.Value.Kind() {
+	case slog.KindGroup:
+		attrs := a.Value.Group()
+		if len(attrs) == 0 {
+			return
+		}
+		if a.Key == "" {
+			for _, ga := range attrs {
+				appendAttr(evt, ga)
+			}
+			return
+		}
+		dict := zerolog.Dict()
+		for _, ga := range attrs {
+			appendAttr(dict, ga)
+		}
+		evt.Dict(a.Key, dict)
+	case slog.KindString:
+		evt.Str(a.Key, a.Value.String())
+	case slog.KindInt64:
+		evt.Int64(a.Key, a.Value.Int64())
+	case slog.KindUint64:
+		evt.Uint64(a.Key, a.Value.Uint64())
+	case slog.KindFloat64:
+		evt.Float64(a.Key, a.Value.Float64())
+	case slog.KindBool:
+		evt.Bool(a.Key, a.Value.Bool())
+	case slog.KindDuration:
+		evt.Dur(a.Key, a.Value.Duration())
+	case slog.KindTime:
+		evt.Time(a.Key, a.Value.Time())
+	default:
+		if err, ok := a.Value.Any().(error); ok {
+			evt.AnErr(a.Key, err)
+			return
+		}
+		evt.Interface(a.Key, a.Value.Any())
+	}
+}
+
+// slogLevel maps slog.Level to the closest lower zerolog.Level.
+func slogLevel(level slog.Level) zerolog.Level {
+	switch {
+	case level < slog.LevelDebug:
+		return zerolog.TraceLevel
+	case level < slog.LevelInfo:
+		return zerolog.DebugLevel
+	case level < slog.LevelWarn:
+		return zerolog.InfoLevel
+	case level < slog.LevelError:
+		return zerolog.WarnLevel
+	default:
+		return zerolog.ErrorLevel
+	}
+}
@@ -0,0 +1,121 @@
+//go:build go1.21
+// +build go1.21
+
+package zltest
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Tester_Slog(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := tst.Slog()
+	tim := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// --- When ---
+	log.Info(
+		"message",
+		"str", "val",
+		"int", 42,
+		"bool", true,
+		"dur", time.Second,
+		"at", tim,
+		"err", errors.New("test"),
+	)
+
+	// --- Then ---
+	ent := tst.LastEntry()
+	ent.ExpLevel(zerolog.InfoLevel)
+	ent.ExpMsg("message")
+	ent.ExpStr("str", "val")
+	ent.ExpNum("int", 42)
+	ent.ExpBool("bool", true)
+	ent.ExpDur("dur", time.Second)
+	ent.ExpTime("at", tim)
+	ent.ExpStr("err", "test")
+	ent.ExpLoggedWithin(time.Now(), time.Minute)
+	ent.ExpKeysOrdered("level", "time", "str", "int", "bool", "dur", "at", "err", "message")
+}
+
+func Test_Tester_Slog_groups(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := slog.New(tst.SlogHandler()).
+		With("svc", "api").
+		WithGroup("req").
+		With("id", 1).
+		WithGroup("user")
+
+	// --- When ---
+	log.Warn("message", "name", "bob", slog.Group("addr", "city", "NYC"))
+	log.Warn("empty")
+
+	// --- Then ---
+	ets := tst.Entries()
+	ets.ExpLen(2)
+
+	ent := ets.ExpEntry(0)
+	ent.ExpLevel(zerolog.WarnLevel)
+	ent.ExpStr("svc", "api")
+	ent.ExpFields(map[string]interface{}{
+		"req": map[string]interface{}{
+			"id": 1,
+			"user": map[string]interface{}{
+				"name": "bob",
+				"addr": map[string]interface{}{"city": "NYC"},
+			},
+		},
+	})
+
+	ent = ets.ExpEntry(1)
+	ent.ExpFields(map[string]interface{}{
+		"req": map[string]interface{}{"id": 1},
+	})
+	ent.NotExpKey("user")
+}
+
+func Test_Tester_Slog_inlineGroup(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := tst.Slog()
+
+	// --- When ---
+	log.Error("message", slog.Group("", "a", 1), slog.Group("empty"), slog.Attr{})
+
+	// --- Then ---
+	ent := tst.LastEntry()
+	ent.ExpLevel(zerolog.ErrorLevel)
+	ent.ExpNum("a", 1)
+	ent.NotExpKey("empty")
+	ent.ExpNumKeys(4)
+}
+
+func Test_slogLevel(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		level slog.Level
+		exp   zerolog.Level
+	}{
+		{"1", slog.LevelDebug - 1, zerolog.TraceLevel},
+		{"2", slog.LevelDebug, zerolog.DebugLevel},
+		{"3", slog.LevelInfo, zerolog.InfoLevel},
+		{"4", slog.LevelInfo + 2, zerolog.InfoLevel},
+		{"5", slog.LevelWarn, zerolog.WarnLevel},
+		{"6", slog.LevelError, zerolog.ErrorLevel},
+		{"7", slog.LevelError + 4, zerolog.ErrorLevel},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			assert.Exactly(t, tc.exp, slogLevel(tc.level), "test %s", tc.testN)
+		})
+	}
+}
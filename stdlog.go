@@ -0,0 +1,53 @@
+package zltest
+
+import (
+	"log"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// StdLogger returns standard library log.Logger writing every message to
+// the Tester as a log entry at given level with the message in the
+// zerolog.MessageFieldName field.
+func (tst *Tester) StdLogger(level zerolog.Level) *log.Logger {
+	return log.New(tst.stdWriter(level), "", 0)
+}
+
+// CaptureStdLog is Tester option redirecting the standard library logger
+// output to the Tester for the duration of the test. Messages are logged
+// without level. The logger output, flags and prefix are restored in
+// T.Cleanup function. Because it changes global state it must not be used
+// in parallel tests.
+func CaptureStdLog() Option {
+	return func(tst *Tester) {
+		out, flags, prefix := log.Writer(), log.Flags(), log.Prefix()
+		log.SetOutput(tst.stdWriter(zerolog.NoLevel))
+		log.SetFlags(0)
+		log.SetPrefix("")
+
+		tst.t.Cleanup(func() {
+			log.SetOutput(out)
+			log.SetFlags(flags)
+			log.SetPrefix(prefix)
+		})
+	}
+}
+
+// stdWriter returns writer for log.Logger writing to the Tester.
+func (tst *Tester) stdWriter(level zerolog.Level) *stdWriter {
+	return &stdWriter{log: zerolog.New(tst), lvl: level}
+}
+
+// stdWriter implements io.Writer interface for log.Logger. The log.Logger
+// calls Write once for every message.
+type stdWriter struct {
+	log zerolog.Logger // Logger writing to the Tester.
+	lvl zerolog.Level  // Level of logged entries.
+}
+
+// Write implements io.Writer interface.
+func (sw *stdWriter) Write(p []byte) (n int, err error) {
+	sw.log.WithLevel(sw.lvl).Msg(strings.TrimSuffix(string(p), "\n"))
+	return len(p), nil
+}
@@ -0,0 +1,72 @@
+package zltest
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/rzajac/zltest/internal"
+)
+
+func Test_Tester_StdLogger(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := tst.StdLogger(zerolog.WarnLevel)
+
+	// --- When ---
+	log.Printf("message %d", 1)
+	log.Print("multi\nline")
+
+	// --- Then ---
+	ets := tst.Entries()
+	ets.ExpLen(2)
+	ets.ExpEntry(0).ExpLevel(zerolog.WarnLevel)
+	ets.ExpEntry(0).ExpMsg("message 1")
+	ets.ExpEntry(1).ExpMsg("multi\nline")
+}
+
+func Test_CaptureStdLog(t *testing.T) {
+	// --- Given ---
+	var cleanup func()
+
+	mck := &TMock{}
+	mck.On("Cleanup", mock.AnythingOfType("func()")).Run(func(args mock.Arguments) {
+		cleanup = args.Get(0).(func())
+	})
+	mck.On("Helper")
+
+	defer func(w io.Writer, flags int, prefix string) {
+		log.SetOutput(w)
+		log.SetFlags(flags)
+		log.SetPrefix(prefix)
+	}(log.Writer(), log.Flags(), log.Prefix())
+
+	out := &bytes.Buffer{}
+	log.SetOutput(out)
+	log.SetFlags(log.Lshortfile)
+	log.SetPrefix("prefix ")
+
+	tst := New(mck, CaptureStdLog())
+
+	// --- When ---
+	log.Print("captured")
+	require.NotNil(t, cleanup)
+	cleanup()
+	log.Print("not captured")
+
+	// --- Then ---
+	ent := tst.LastEntry()
+	ent.ExpMsg("captured")
+	ent.ExpNumKeys(1)
+	mck.AssertExpectations(t)
+	assert.Exactly(t, 1, tst.Len())
+	assert.Exactly(t, log.Lshortfile, log.Flags())
+	assert.Exactly(t, "prefix ", log.Prefix())
+	assert.Contains(t, out.String(), "not captured")
+}
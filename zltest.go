@@ -4,6 +4,7 @@ package zltest
 import (
 	"bytes"
 	"encoding/json"
+	"strings"
 	"sync"
 
 	"github.com/rs/zerolog"
@@ -14,15 +15,57 @@ type Tester struct {
 	mx  sync.RWMutex // Guards the buffer.
 	buf []byte       // Buffer zerolog writes to.
 	cnt int          // Number of all log messages (calls to Write).
+	r   Renderer     // Renderer used to print entries.
+	cs  *contracts   // Contract registry.
 	t   T            // Test manager.
 }
 
+// Option represents Tester option.
+type Option func(*Tester)
+
+// Matcher represents function matching log entries.
+type Matcher func(*Entry) bool
+
+// FailOnErrorLogs registers T.Cleanup function which calls ExpNoErrors with
+// given allow list when the test completes.
+func FailOnErrorLogs(allow ...Matcher) Option {
+	return func(tst *Tester) {
+		tst.t.Cleanup(func() {
+			tst.t.Helper()
+			tst.ExpNoErrors(allow...)
+		})
+	}
+}
+
+// PrintOnFailure registers T.Cleanup function which prints all logged
+// entries when the test fails. It requires T passed to New to implement TB,
+// otherwise it does nothing.
+func PrintOnFailure() Option {
+	return func(tst *Tester) {
+		tb, ok := tst.t.(TB)
+		if !ok {
+			return
+		}
+		tst.t.Cleanup(func() {
+			tst.t.Helper()
+			if tb.Failed() {
+				tst.Entries().Print()
+			}
+		})
+	}
+}
+
 // New creates new instance of zerolog tester.
-func New(t T) *Tester {
-	return &Tester{
+func New(t T, opts ...Option) *Tester {
+	tst := &Tester{
 		buf: make([]byte, 0, 500),
+		cs:  newContracts(),
 		t:   t,
 	}
+	for _, opt := range opts {
+		opt(tst)
+	}
+	return tst
 }
 
 // Logger returns zerolog.Logger using this tester as io.Writer.
@@ -30,13 +73,24 @@ func (tst *Tester) Logger() zerolog.Logger {
 	return zerolog.New(tst)
 }
 
+// Name returns the name of the running test or empty string when T passed
+// to New doesn't implement TB.
+func (tst *Tester) Name() string {
+	if tb, ok := tst.t.(TB); ok {
+		return tb.Name()
+	}
+	return ""
+}
+
 // Write implements io.Writer interface.
 func (tst *Tester) Write(p []byte) (n int, err error) {
 	tst.mx.Lock()
-	defer tst.mx.Unlock()
-
 	tst.cnt++
 	tst.buf = append(tst.buf, p...)
+	idx := tst.cnt - 1
+	tst.mx.Unlock()
+
+	tst.checkWrite(idx, p)
 	return len(p), nil
 }
 
@@ -68,30 +122,51 @@ func (tst *Tester) Entries() Entries {
 		m := make(map[string]interface{})
 		if err := dec.Decode(&m); err != nil {
 			tst.t.Fatal(err)
-			return Entries{t: tst.t}
+			return Entries{r: tst.r, t: tst.t}
 		}
 
-		tmp := tst.buf[off:dec.InputOffset()]
+		tmp := bytes.TrimSpace(tst.buf[off:dec.InputOffset()])
 		off = dec.InputOffset()
+
+		keys, err := entryKeys(tmp)
+		if err != nil {
+			tst.t.Fatal(err)
+			return Entries{r: tst.r, t: tst.t}
+		}
+
 		ets = append(ets, &Entry{
-			raw: string(bytes.TrimSpace(tmp)),
-			m:   m,
-			t:   tst.t,
+			raw:  string(tmp),
+			m:    m,
+			keys: keys,
+			r:    tst.r,
+			t:    tst.t,
 		})
 	}
 
-	return Entries{e: ets, t: tst.t}
+	return Entries{e: ets, r: tst.r, t: tst.t}
 }
 
-// Filter returns only entries matching log level.
+// Filter returns only entries matching log level. Entries without level
+// field match zerolog.NoLevel.
 func (tst *Tester) Filter(level zerolog.Level) Entries {
-	ets := make([]*Entry, 0)
-	for _, ent := range tst.Entries().Get() {
-		if lvl, _ := ent.Str(zerolog.LevelFieldName); lvl == level.String() {
-			ets = append(ets, ent)
-		}
-	}
-	return Entries{e: ets, t: tst.t}
+	return tst.Entries().Filter(level)
+}
+
+// FilterMin returns only entries with log level equal to or above min.
+func (tst *Tester) FilterMin(min zerolog.Level) Entries {
+	return tst.Entries().FilterMin(min)
+}
+
+// FilterRange returns only entries with log level between min and
+// max inclusive.
+func (tst *Tester) FilterRange(min, max zerolog.Level) Entries {
+	return tst.Entries().FilterRange(min, max)
+}
+
+// ExpNoLevelAbove tests that no logged entry has log level above level.
+func (tst *Tester) ExpNoLevelAbove(level zerolog.Level) {
+	tst.t.Helper()
+	tst.Entries().ExpNoLevelAbove(level)
 }
 
 // FirstEntry returns first log entry or nil if no log entries written
@@ -120,6 +195,44 @@ func (tst *Tester) LastEntry() *Entry {
 	return ets[len(ets)-1]
 }
 
+// ExpNoDuplicateKeys tests that none of the logged entries has a field key
+// written more than once.
+func (tst *Tester) ExpNoDuplicateKeys() {
+	tst.t.Helper()
+	for _, ent := range tst.Entries().Get() {
+		ent.ExpNoDuplicateKeys()
+	}
+}
+
+// ExpNoErrors tests that no entry at zerolog.ErrorLevel or above was logged.
+// Entries matching any of the allow matchers are ignored.
+func (tst *Tester) ExpNoErrors(allow ...Matcher) {
+	tst.t.Helper()
+	ets := make([]*Entry, 0)
+	for _, ent := range tst.FilterMin(zerolog.ErrorLevel).Get() {
+		if !matchAny(ent, allow) {
+			ets = append(ets, ent)
+		}
+	}
+	if len(ets) > 0 {
+		tst.t.Errorf(
+			"expected no error entries but got %d:\n%s",
+			len(ets),
+			strings.Join(indent(tst.r, ets), "\n"),
+		)
+	}
+}
+
+// matchAny returns true if entry matches any of the matchers.
+func matchAny(ent *Entry, matchers []Matcher) bool {
+	for _, m := range matchers {
+		if m(ent) {
+			return true
+		}
+	}
+	return false
+}
+
 // Reset resets the Tester.
 func (tst *Tester) Reset() {
 	tst.mx.Lock()
@@ -168,3 +281,19 @@ type T interface {
 	// first called order.
 	Cleanup(func())
 }
+
+// TB is an optional extension of the T interface. When T passed to New also
+// implements TB the Tester enables features depending on the test name or
+// its status. The *testing.T and *testing.B implement it.
+type TB interface {
+	T
+
+	// Failed reports whether the function has failed.
+	Failed() bool
+
+	// Name returns the name of the running test or benchmark.
+	Name() string
+
+	// Skip is equivalent to Log followed by SkipNow.
+	Skip(args ...interface{})
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	. "github.com/rzajac/zltest/internal"
 )
@@ -80,6 +81,38 @@ func Test_Tester_Filter(t *testing.T) {
 	assert.Len(t, tst.Filter(zerolog.FatalLevel).Get(), 0)
 }
 
+func Test_Tester_Filter_noLevel(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Info().Msg("info")
+	log.Log().Msg("no level")
+
+	// --- Then ---
+	got := tst.Filter(zerolog.NoLevel)
+	got.ExpLen(1)
+	got.ExpMsg("no level")
+	assert.Len(t, tst.FilterMin(zerolog.TraceLevel).Get(), 1)
+}
+
+func Test_Tester_FilterMin(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Info().Str("key0", "val0").Send()
+	log.Error().Str("key1", "val1").Send()
+	log.Debug().Str("keyD", "valD").Send()
+
+	// --- Then ---
+	assert.Len(t, tst.FilterMin(zerolog.InfoLevel).Get(), 2)
+	assert.Len(t, tst.FilterRange(zerolog.DebugLevel, zerolog.InfoLevel).Get(), 2)
+	tst.ExpNoLevelAbove(zerolog.ErrorLevel)
+}
+
 func Test_Tester_Entries_noEntries(t *testing.T) {
 	// --- Given ---
 	tst := New(t)
@@ -154,6 +187,184 @@ func Test_Tester_LastEntry_noEntries(t *testing.T) {
 	assert.Nil(t, tst.LastEntry())
 }
 
+func Test_Tester_ExpNoDuplicateKeys(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Info().Str("key0", "val0").Send()
+	log.Error().Str("key0", "val0").Str("key1", "val1").Send()
+
+	// --- Then ---
+	tst.ExpNoDuplicateKeys()
+}
+
+func Test_Tester_ExpNoDuplicateKeys_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected entry to have no duplicate keys but got '%s' in:\n%s",
+		"key0",
+		`  {"level":"error","key0":"val0","key0":"val1"}`,
+	).Once()
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Info().Str("key0", "val0").Send()
+	log.Error().Str("key0", "val0").Str("key0", "val1").Send()
+
+	// --- When ---
+	tst.ExpNoDuplicateKeys()
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Tester_ExpNoErrors(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+	log := zerolog.New(tst)
+
+	// --- When ---
+	log.Info().Msg("info")
+	log.Warn().Msg("warn")
+	log.Error().Msg("expected")
+
+	// --- Then ---
+	tst.ExpNoErrors(func(ent *Entry) bool {
+		msg, _ := ent.Str(zerolog.MessageFieldName)
+		return msg == "expected"
+	})
+}
+
+func Test_Tester_ExpNoErrors_error(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On(
+		"Errorf",
+		"expected no error entries but got %d:\n%s",
+		2,
+		`  {"level":"error","message":"error"}`+"\n"+`  {"level":"fatal","message":"fatal"}`,
+	)
+
+	tst := New(mck)
+	log := zerolog.New(tst)
+	log.Info().Msg("info")
+	log.Error().Msg("error")
+	log.WithLevel(zerolog.FatalLevel).Msg("fatal")
+
+	// --- When ---
+	tst.ExpNoErrors()
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Tester_FailOnErrorLogs(t *testing.T) {
+	// --- Given ---
+	var cleanup func()
+
+	mck := &TMock{}
+	mck.On("Helper")
+	mck.On("Cleanup", mock.AnythingOfType("func()")).Run(func(args mock.Arguments) {
+		cleanup = args.Get(0).(func())
+	})
+	mck.On(
+		"Errorf",
+		"expected no error entries but got %d:\n%s",
+		1,
+		`  {"level":"error","message":"error"}`,
+	)
+
+	tst := New(mck, FailOnErrorLogs(func(ent *Entry) bool {
+		msg, _ := ent.Str(zerolog.MessageFieldName)
+		return msg == "allowed"
+	}))
+	log := zerolog.New(tst)
+	log.Error().Msg("allowed")
+	log.Error().Msg("error")
+
+	// --- When ---
+	require.NotNil(t, cleanup)
+	cleanup()
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Tester_Name(t *testing.T) {
+	// --- Given ---
+	tst := New(t)
+
+	// --- Then ---
+	assert.Exactly(t, "Test_Tester_Name", tst.Name())
+	assert.Exactly(t, "", New(&TMock{}).Name())
+}
+
+func Test_Tester_PrintOnFailure(t *testing.T) {
+	// --- Given ---
+	var cleanup func()
+
+	mck := &TBMock{}
+	mck.On("Helper")
+	mck.On("Cleanup", mock.AnythingOfType("func()")).Run(func(args mock.Arguments) {
+		cleanup = args.Get(0).(func())
+	})
+	mck.On("Failed").Return(true)
+	mck.On("Name").Return("TestName")
+	mck.On("Log", "entries logged so far in TestName:")
+	mck.On("Log", `  {"level":"error","key0":"val0"}`)
+
+	tst := New(mck, PrintOnFailure())
+	log := zerolog.New(tst)
+	log.Error().Str("key0", "val0").Send()
+
+	// --- When ---
+	require.NotNil(t, cleanup)
+	cleanup()
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Tester_PrintOnFailure_notFailed(t *testing.T) {
+	// --- Given ---
+	var cleanup func()
+
+	mck := &TBMock{}
+	mck.On("Cleanup", mock.AnythingOfType("func()")).Run(func(args mock.Arguments) {
+		cleanup = args.Get(0).(func())
+	})
+	mck.On("Helper")
+	mck.On("Failed").Return(false)
+
+	tst := New(mck, PrintOnFailure())
+	log := zerolog.New(tst)
+	log.Error().Str("key0", "val0").Send()
+
+	// --- When ---
+	require.NotNil(t, cleanup)
+	cleanup()
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
+func Test_Tester_PrintOnFailure_notTB(t *testing.T) {
+	// --- Given ---
+	mck := &TMock{}
+
+	// --- When ---
+	New(mck, PrintOnFailure())
+
+	// --- Then ---
+	mck.AssertExpectations(t)
+}
+
 func Test_Tester_Reset(t *testing.T) {
 	// --- Given ---
 	tst := New(t)